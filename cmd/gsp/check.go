@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/samburba/go-system-profiler/v2/policy"
+)
+
+// runCheck evaluates a policy file and fails when a rule does not pass
+func runCheck(ctx context.Context, args []string, stdout io.Writer) error {
+	var o options
+	fs := flag.NewFlagSet("check", flag.ContinueOnError)
+	fs.StringVar(&o.format, "format", "text", "output format: text, json, sarif or junit")
+	o.registerSnapshotFlags(fs)
+	files, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		return usagef("expected one policy file")
+	}
+	switch o.format {
+	case "text", formatJSON, "sarif", "junit":
+	default:
+		return usagef("unknown format %q", o.format)
+	}
+
+	p, err := policy.Load(files[0])
+	if err != nil {
+		return err
+	}
+
+	// Rules on data types missing from --input fail on their own instead of
+	// aborting the whole check.
+	s, err := o.load(ctx, p.Types()...)
+	if err != nil {
+		return err
+	}
+
+	report := policy.Evaluate(p, s)
+	switch o.format {
+	case formatJSON:
+		err = policy.WriteJSON(stdout, report)
+	case "sarif":
+		err = policy.WriteSARIF(stdout, report, filepath.ToSlash(files[0]))
+	case "junit":
+		err = policy.WriteJUnit(stdout, report)
+	default:
+		err = policy.WriteText(stdout, report)
+	}
+	if err != nil {
+		return err
+	}
+	if failed := len(report.Failed()); failed > 0 {
+		return fmt.Errorf("%d of %d rules failed", failed, len(report.Results))
+	}
+	return nil
+}
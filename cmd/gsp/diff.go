@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"strings"
+
+	"github.com/samburba/go-system-profiler/v2/diff"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// runDiff compares two snapshot files
+func runDiff(_ context.Context, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	format := fs.String("format", "text", "output format: text or json")
+	ignore := fs.String("ignore", strings.Join(diff.DefaultIgnore, ","), "comma separated fields that are not reported as modified")
+	files, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 2 {
+		return usagef("expected two snapshot files")
+	}
+	if *format != "text" && *format != formatJSON {
+		return usagef("unknown format %q", *format)
+	}
+
+	a, err := snapshot.Load(files[0])
+	if err != nil {
+		return err
+	}
+	b, err := snapshot.Load(files[1])
+	if err != nil {
+		return err
+	}
+
+	var opts diff.Options
+	for _, field := range strings.Split(*ignore, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			opts.Ignore = append(opts.Ignore, field)
+		}
+	}
+	report, err := diff.Snapshots(a, b, opts)
+	if err != nil {
+		return err
+	}
+	if *format == formatJSON {
+		return diff.WriteJSON(stdout, report)
+	}
+	return diff.WriteText(stdout, report)
+}
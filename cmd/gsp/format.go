@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/samburba/go-system-profiler/v2/normalize"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// Output formats
+const (
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+	formatTable = "table"
+	formatCSV   = "csv"
+)
+
+// checkFormat returns a usage error for unknown output formats
+func checkFormat(format string) error {
+	switch format {
+	case formatJSON, formatYAML, formatTable, formatCSV:
+		return nil
+	}
+	return usagef("unknown format %q", format)
+}
+
+// section is the decoded items of one data type
+type section struct {
+	Type  snapshot.SPDataType
+	Items interface{}
+}
+
+// writeSections writes the items of several data types. JSON and YAML output
+// is an object keyed by data type; table output prints one table per type.
+func writeSections(w io.Writer, format string, sections []section) error {
+	switch format {
+	case formatJSON, formatYAML:
+		out := make(map[snapshot.SPDataType]interface{}, len(sections))
+		for _, s := range sections {
+			out[s.Type] = s.Items
+		}
+		return write(w, format, out)
+	case formatTable, formatCSV:
+		for i, s := range sections {
+			if len(sections) > 1 {
+				if i > 0 {
+					fmt.Fprintln(w)
+				}
+				fmt.Fprintf(w, "%s\n", s.Type)
+			}
+			if err := write(w, format, s.Items); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return usagef("unknown format %q", format)
+}
+
+// write encodes v in the given format. For table and csv output v must be a
+// slice; columns fixes the column order, otherwise every scalar field is shown
+// with "_name" first.
+func write(w io.Writer, format string, v interface{}, columns ...string) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatYAML:
+		g, err := generic(v)
+		if err != nil {
+			return err
+		}
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(g); err != nil {
+			return err
+		}
+		return enc.Close()
+	case formatTable, formatCSV:
+		rows, err := rowsOf(v)
+		if err != nil {
+			return err
+		}
+		if len(columns) == 0 {
+			columns = columnsOf(rows, format == formatCSV)
+		}
+		if format == formatCSV {
+			return writeCSV(w, rows, columns)
+		}
+		return writeTable(w, rows, columns)
+	}
+	return usagef("unknown format %q", format)
+}
+
+// writeTable writes rows as aligned text columns
+func writeTable(w io.Writer, rows []map[string]interface{}, columns []string) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for i, column := range columns {
+		if i > 0 {
+			fmt.Fprint(tw, "\t")
+		}
+		fmt.Fprint(tw, column)
+	}
+	fmt.Fprintln(tw)
+	for _, row := range rows {
+		for i, column := range columns {
+			if i > 0 {
+				fmt.Fprint(tw, "\t")
+			}
+			fmt.Fprint(tw, cell(row[column]))
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+// writeCSV writes rows as comma separated values with a header line
+func writeCSV(w io.Writer, rows []map[string]interface{}, columns []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	record := make([]string, len(columns))
+	for _, row := range rows {
+		for i, column := range columns {
+			record[i] = cell(row[column])
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// rowsOf converts a slice into one generic map per element
+func rowsOf(v interface{}) ([]map[string]interface{}, error) {
+	g, err := generic(v)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := g.([]interface{})
+	if !ok {
+		return nil, usagef("table and csv output need a list of items")
+	}
+	rows := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		row, ok := item.(map[string]interface{})
+		if !ok {
+			row = map[string]interface{}{"value": item}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// columnsOf returns the union of the row keys, with "_name" first. Nested
+// values are only included when nested is true.
+func columnsOf(rows []map[string]interface{}, nested bool) []string {
+	seen := map[string]bool{}
+	var columns []string
+	for _, row := range rows {
+		for key, value := range row {
+			if seen[key] {
+				continue
+			}
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				if !nested {
+					continue
+				}
+			}
+			seen[key] = true
+			columns = append(columns, key)
+		}
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		if columns[i] == "_name" || columns[j] == "_name" {
+			return columns[i] == "_name"
+		}
+		return columns[i] < columns[j]
+	})
+	return columns
+}
+
+// cell formats a value for a table or csv cell
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+	return fmt.Sprint(v)
+}
+
+// generic converts v into maps, slices and scalars through its JSON
+// encoding, keeping integers exact
+func generic(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var g interface{}
+	if err := dec.Decode(&g); err != nil {
+		return nil, err
+	}
+	return numbers(g), nil
+}
+
+// normalized converts v like generic and replaces Apple's internal tokens
+// and localized values with their canonical value, e.g.
+// "coreaudio_device_type_usb" with "usb"
+func normalized(v interface{}) (interface{}, error) {
+	g, err := generic(v)
+	if err != nil {
+		return nil, err
+	}
+	return canonical(g), nil
+}
+
+// canonical replaces the token strings of a generic value
+func canonical(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = canonical(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = canonical(value)
+		}
+	case string:
+		if c, ok := normalize.Token(v); ok {
+			return c
+		}
+	}
+	return v
+}
+
+// numbers replaces json.Number values with int64 or float64
+func numbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = numbers(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = numbers(value)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	}
+	return v
+}
@@ -0,0 +1,342 @@
+// Command gsp prints macOS system profiler data using the models of the
+// go-system-profiler type packages.
+//
+// Usage:
+//
+//	gsp list
+//	gsp get [flags] <type>...
+//	gsp dump [flags]
+//	gsp diff [flags] <old.json> <new.json>
+//	gsp serve --prometheus [flags]
+//	gsp check [flags] <policy.yaml>
+//
+// Data types can be given by their full name ("SPAudioDataType") or by the
+// name of their package ("audio"). "gsp get" prints the items of the type
+// packages with Apple's internal tokens normalized, so that
+// "coreaudio_device_type_usb" reads "usb"; --raw keeps the values as
+// reported. With --input, gsp reads a file written by
+// "gsp dump" or by "system_profiler -json" instead of running system_profiler.
+// With --redact drop|mask|hash, serial numbers, hardware identifiers, MAC and
+// Bluetooth addresses, user and host names and Wi-Fi SSIDs are redacted; hash
+// reads its HMAC key from the GSP_REDACT_KEY environment variable.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/samburba/go-system-profiler/v2/redact"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// redactKeyEnv names the environment variable holding the HMAC key of
+// --redact hash
+const redactKeyEnv = "GSP_REDACT_KEY"
+
+// command is a gsp subcommand
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(ctx context.Context, args []string, stdout io.Writer) error
+}
+
+// commands lists the subcommands in the order they are shown in the usage
+var commands = []command{
+	{"list", "[flags]", "List the supported data types", runList},
+	{"get", "[flags] <type>...", "Print one or more data types", runGet},
+	{"dump", "[flags]", "Print a full snapshot that can be read back with --input", runDump},
+	{"diff", "[flags] <old.json> <new.json>", "Show what changed between two snapshots", runDiff},
+	{"serve", "--prometheus [flags]", "Serve metrics over HTTP", runServe},
+	{"check", "[flags] <policy.yaml>", "Evaluate compliance rules; exits 1 when a rule fails", runCheck},
+}
+
+// usageError reports a command line mistake; the usage of the command is
+// printed after it
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// usagef returns a usageError with a formatted message
+func usagef(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run executes the subcommand named by args and returns the exit code
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+		err := cmd.run(ctx, args[1:], stdout)
+		switch {
+		case err == nil:
+			return 0
+		case errors.Is(err, flag.ErrHelp):
+			return 0
+		case errors.As(err, new(*usageError)):
+			fmt.Fprintf(stderr, "gsp %s: %v\nusage: gsp %s %s\n", cmd.name, err, cmd.name, cmd.args)
+			return 2
+		default:
+			fmt.Fprintf(stderr, "gsp %s: %v\n", cmd.name, err)
+			return 1
+		}
+	}
+
+	fmt.Fprintf(stderr, "gsp: unknown command %q\n", args[0])
+	usage(stderr)
+	return 2
+}
+
+// usage prints the list of subcommands
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: gsp <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-6s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Run "gsp <command> -h" for the flags of a command.`)
+}
+
+// options holds the flags shared by the subcommands
+type options struct {
+	format  string
+	detail  string
+	timeout time.Duration
+	input   string
+	redact  string
+}
+
+// newFlagSet returns a flag set with the shared flags registered
+func newFlagSet(name, defaultFormat string, o *options) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.StringVar(&o.format, "format", defaultFormat, "output format: json, yaml, table or csv")
+	o.registerSnapshotFlags(fs)
+	return fs
+}
+
+// registerSnapshotFlags registers the flags that control data collection
+func (o *options) registerSnapshotFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.detail, "detail", "", "system_profiler detail level: mini, basic or full")
+	fs.DurationVar(&o.timeout, "timeout", 0, "maximum time system_profiler may spend on each data type")
+	fs.StringVar(&o.input, "input", "", "read data from a snapshot or system_profiler JSON file instead of running system_profiler")
+	fs.StringVar(&o.redact, "redact", "", "redact identifying fields: drop, mask or hash (key from $"+redactKeyEnv+")")
+}
+
+// parseFlags parses flags that may appear before, between or after the
+// positional arguments and returns the positional arguments
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, usagef("%v", err)
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// snapshot loads the input file or runs system_profiler for the given types
+func (o *options) snapshot(ctx context.Context, types ...snapshot.SPDataType) (*snapshot.Snapshot, error) {
+	s, err := o.load(ctx, types...)
+	if err != nil {
+		return nil, err
+	}
+	for _, spType := range types {
+		if s.Has(spType) {
+			continue
+		}
+		if o.input == "" {
+			return nil, fmt.Errorf("system_profiler reported no %s", spType)
+		}
+		return nil, fmt.Errorf("%s does not contain %s", o.input, spType)
+	}
+	return s, nil
+}
+
+// load is like snapshot but accepts an input file that lacks some of the
+// types
+func (o *options) load(ctx context.Context, types ...snapshot.SPDataType) (*snapshot.Snapshot, error) {
+	r, err := o.redactor()
+	if err != nil {
+		return nil, err
+	}
+
+	var s *snapshot.Snapshot
+	if o.input != "" {
+		s, err = snapshot.Load(o.input)
+	} else {
+		level, levelErr := o.detailLevel()
+		if levelErr != nil {
+			return nil, levelErr
+		}
+		s, err = snapshot.Collect(ctx, snapshot.Options{DetailLevel: level, Timeout: o.timeout}, types...)
+	}
+	if err != nil || r == nil {
+		return s, err
+	}
+	return r.Snapshot(s)
+}
+
+// redactor validates the --redact flag; it returns nil when nothing is
+// redacted
+func (o *options) redactor() (*redact.Redactor, error) {
+	if o.redact == "" {
+		return nil, nil
+	}
+	mode, err := redact.ParseMode(o.redact)
+	if err != nil {
+		return nil, usagef("%v", err)
+	}
+	key := os.Getenv(redactKeyEnv)
+	if mode == redact.Hash && key == "" {
+		return nil, usagef("--redact hash needs a key in $%s", redactKeyEnv)
+	}
+	return redact.New(redact.Options{Mode: mode, Key: []byte(key)})
+}
+
+// detailLevel validates the --detail flag
+func (o *options) detailLevel() (snapshot.DetailLevel, error) {
+	level := snapshot.DetailLevel(o.detail)
+	switch level {
+	case "", snapshot.DetailMini, snapshot.DetailBasic, snapshot.DetailFull:
+		return level, nil
+	}
+	return "", usagef("unknown detail level %q", o.detail)
+}
+
+// resolveTypes maps type names given on the command line to data types
+func resolveTypes(names []string) ([]snapshot.SPDataType, error) {
+	types := make([]snapshot.SPDataType, 0, len(names))
+	for _, name := range names {
+		spType, ok := snapshot.Lookup(name)
+		if !ok {
+			return nil, usagef("unknown data type %q (see gsp list)", name)
+		}
+		types = append(types, spType)
+	}
+	return types, nil
+}
+
+// runList prints the supported data types
+func runList(_ context.Context, args []string, stdout io.Writer) error {
+	var o options
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	fs.StringVar(&o.format, "format", formatTable, "output format: json, yaml, table or csv")
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usagef("unexpected arguments")
+	}
+
+	if err := checkFormat(o.format); err != nil {
+		return err
+	}
+
+	rows := []map[string]interface{}{}
+	for _, spType := range snapshot.Supported() {
+		rows = append(rows, map[string]interface{}{"name": snapshot.ShortName(spType), "type": string(spType)})
+	}
+	return write(stdout, o.format, rows, "name", "type")
+}
+
+// runGet prints the typed items of the requested data types
+func runGet(ctx context.Context, args []string, stdout io.Writer) error {
+	var o options
+	fs := newFlagSet("get", formatJSON, &o)
+	raw := fs.Bool("raw", false, "print values as system_profiler reports them instead of normalizing Apple's internal tokens")
+	names, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return usagef("no data type given")
+	}
+	types, err := resolveTypes(names)
+	if err != nil {
+		return err
+	}
+	if err := checkFormat(o.format); err != nil {
+		return err
+	}
+	if o.format == formatCSV && len(types) > 1 {
+		return usagef("csv output supports a single data type")
+	}
+
+	s, err := o.snapshot(ctx, types...)
+	if err != nil {
+		return err
+	}
+
+	sections := make([]section, 0, len(types))
+	for _, spType := range types {
+		items, err := s.Get(spType)
+		if err != nil {
+			return err
+		}
+		if !*raw {
+			if items, err = normalized(items); err != nil {
+				return err
+			}
+		}
+		sections = append(sections, section{Type: spType, Items: items})
+	}
+	return writeSections(stdout, o.format, sections)
+}
+
+// runDump prints a snapshot of every data type
+func runDump(ctx context.Context, args []string, stdout io.Writer) error {
+	var o options
+	fs := newFlagSet("dump", formatJSON, &o)
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usagef("unexpected arguments")
+	}
+	if o.format != formatJSON && o.format != formatYAML {
+		return usagef("dump supports json and yaml output")
+	}
+
+	s, err := o.snapshot(ctx)
+	if err != nil {
+		return err
+	}
+	return write(stdout, o.format, s)
+}
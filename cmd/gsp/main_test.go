@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const fixture = `{
+	"SPAudioDataType": [{
+		"_name": "coreaudio_device",
+		"_items": [
+			{"_name": "MacBook Pro Speakers", "coreaudio_device_transport": "coreaudio_device_type_builtin"},
+			{"_name": "USB Microphone", "coreaudio_device_transport": "coreaudio_device_type_usb"}
+		]
+	}]
+}`
+
+// writeFixture stores the fixture in a temporary file and returns its path
+func writeFixture(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	if err := os.WriteFile(path, []byte(fixture), 0o644); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+	return path
+}
+
+func TestGetFormats(t *testing.T) {
+	input := writeFixture(t)
+	cases := map[string]string{
+		"json":  `"_name": "USB Microphone"`,
+		"yaml":  "- _name: USB Microphone",
+		"table": "USB Microphone        usb",
+		"csv":   "USB Microphone,usb",
+	}
+	for format, want := range cases {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), []string{"get", "audio", "--input", input, "--format", format}, &stdout, &stderr)
+		if code != 0 {
+			t.Fatalf("%s: exit code %d: %s", format, code, stderr.String())
+		}
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("%s: expected %q in output:\n%s", format, want, stdout.String())
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run(context.Background(), []string{"get", "audio", "--raw", "--input", input, "--format", "csv"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("Exit code %d: %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "USB Microphone,coreaudio_device_type_usb") {
+		t.Errorf("Expected raw values in output:\n%s", stdout.String())
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	input := writeFixture(t)
+	cases := [][]string{
+		{"get", "--input", input},
+		{"get", "teleporter", "--input", input},
+		{"get", "audio", "--format", "xml", "--input", input},
+		{"dump", "--format", "table", "--input", input},
+		{"dump", "--redact", "scramble", "--input", input},
+		{"list", "--input", input},
+		{"unknown"},
+	}
+	for _, args := range cases {
+		var stdout, stderr bytes.Buffer
+		if code := run(context.Background(), args, &stdout, &stderr); code != 2 {
+			t.Errorf("%v: expected exit code 2, got %d", args, code)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run(context.Background(), []string{"get", "power", "--input", input}, &stdout, &stderr); code != 1 {
+		t.Errorf("Expected exit code 1 for a type missing from the input, got %d", code)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	input := filepath.Join(t.TempDir(), "hardware.json")
+	data := `{"SPHardwareDataType": [{"_name": "hardware_overview", "machine_model": "Mac15,3", "serial_number": "C02XK0ABJG5J"}]}`
+	if err := os.WriteFile(input, []byte(data), 0o644); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run(context.Background(), []string{"get", "hardware", "--redact", "mask", "--input", input}, &stdout, &stderr); code != 0 {
+		t.Fatalf("Exit code %d: %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "********JG5J") || strings.Contains(stdout.String(), "C02XK0ABJG5J") || !strings.Contains(stdout.String(), "Mac15,3") {
+		t.Errorf("Expected a masked serial number in output:\n%s", stdout.String())
+	}
+
+	t.Setenv(redactKeyEnv, "")
+	stdout.Reset()
+	stderr.Reset()
+	if code := run(context.Background(), []string{"dump", "--redact", "hash", "--input", input}, &stdout, &stderr); code != 2 {
+		t.Errorf("Expected exit code 2 for hash without a key, got %d", code)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := writeFixture(t)
+	changed := filepath.Join(t.TempDir(), "changed.json")
+	data := strings.Replace(fixture, "USB Microphone", "Studio Display Microphone", 1)
+	if err := os.WriteFile(changed, []byte(data), 0o644); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run(context.Background(), []string{"diff", old, changed}, &stdout, &stderr); code != 0 {
+		t.Fatalf("Exit code %d: %s", code, stderr.String())
+	}
+	for _, want := range []string{"+ Studio Display Microphone", "- USB Microphone"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("Expected %q in output:\n%s", want, stdout.String())
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	input := writeFixture(t)
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	rules := `rules:
+  - id: builtin-speakers
+    path: audio.Transport
+    op: contains
+    value: builtin
+    match: any
+  - id: no-usb-audio
+    path: audio
+    op: count
+    where: {path: coreaudio_device_transport, op: eq, value: usb}
+    value: 0
+`
+	if err := os.WriteFile(policyFile, []byte(rules), 0o644); err != nil {
+		t.Fatalf("Failed to write policy: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run(context.Background(), []string{"check", "--input", input, policyFile}, &stdout, &stderr); code != 1 {
+		t.Fatalf("Expected exit code 1, got %d: %s", code, stderr.String())
+	}
+	for _, want := range []string{"PASS  builtin-speakers", "FAIL  no-usb-audio", "audio[USB Microphone]", "1 of 2 rules passed"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("Expected %q in output:\n%s", want, stdout.String())
+		}
+	}
+	if !strings.Contains(stderr.String(), "1 of 2 rules failed") {
+		t.Errorf("Unexpected stderr: %s", stderr.String())
+	}
+
+	stdout.Reset()
+	if code := run(context.Background(), []string{"check", "--format", "junit", "--input", input, policyFile}, &stdout, &stderr); code != 1 {
+		t.Fatalf("Expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stdout.String(), `<testcase name="no-usb-audio" classname="policy.audio"`) {
+		t.Errorf("Expected a JUnit testcase in output:\n%s", stdout.String())
+	}
+}
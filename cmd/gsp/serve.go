@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/samburba/go-system-profiler/v2/exporter"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// runServe serves Prometheus metrics until ctx is done
+func runServe(ctx context.Context, args []string, stdout io.Writer) error {
+	var o options
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	prometheus := fs.Bool("prometheus", false, "serve Prometheus metrics on /metrics")
+	listen := fs.String("listen", ":9955", "address to listen on")
+	interval := fs.Duration("interval", exporter.DefaultInterval, "time between two collections")
+	o.registerSnapshotFlags(fs)
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usagef("unexpected arguments")
+	}
+	if !*prometheus {
+		return usagef("nothing to serve, use --prometheus")
+	}
+
+	// Report flag mistakes now rather than on the first collection
+	if _, err := o.detailLevel(); err != nil {
+		return err
+	}
+	if _, err := o.redactor(); err != nil {
+		return err
+	}
+	e := exporter.New(exporter.Options{
+		Interval: *interval,
+		Collect: func(ctx context.Context, _ snapshot.Options, types ...snapshot.SPDataType) (*snapshot.Snapshot, error) {
+			return o.load(ctx, types...)
+		},
+	})
+
+	listener, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e)
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go e.Run(ctx)
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdown)
+	}()
+
+	fmt.Fprintf(stdout, "Serving metrics on http://%s/metrics\n", listener.Addr())
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,361 @@
+// Package diff compares snapshots, or two values of the same type package,
+// and reports which items were added, removed or modified.
+//
+// List items are matched by a stable key rather than by position: the
+// application path, the USB serial number, the BSD name or the bundle
+// identifier, falling back to the item name together with location and
+// vendor fields such as the USB location ID, so that identical devices
+// without a serial number are told apart. Only items that still share a key
+// are matched by their position.
+// Nested "_items" lists, such as USB hubs and their devices, are matched
+// recursively and identified by the keys of their parents joined with "/".
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// Kind describes how an item changed.
+type Kind string
+
+// Change kinds.
+const (
+	Added    Kind = "added"
+	Removed  Kind = "removed"
+	Modified Kind = "modified"
+)
+
+// FieldChange is a field whose value differs between two matched items.
+// Nested objects are reported per field, joined with ".".
+type FieldChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// Change is an added, removed or modified item. An empty Key means the whole
+// data type was added to or removed from the snapshot.
+type Change struct {
+	Type   snapshot.SPDataType `json:"type"`
+	Kind   Kind                `json:"kind"`
+	Key    string              `json:"key,omitempty"`
+	Name   string              `json:"name,omitempty"`
+	Fields []FieldChange       `json:"fields,omitempty"`
+}
+
+// Report lists the changes between two snapshots, ordered by data type and
+// item key.
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+// Empty reports whether nothing changed.
+func (r *Report) Empty() bool {
+	return len(r.Changes) == 0
+}
+
+// Options controls a comparison.
+type Options struct {
+	// Ignore lists field names, or dotted field paths, that are never
+	// reported as modified.
+	Ignore []string
+}
+
+// DefaultIgnore lists fields that change every time system_profiler runs.
+var DefaultIgnore = []string{
+	"uptime",
+	"free_space",
+	"free_space_in_bytes",
+	"spstorage_free_space",
+	"spstorage_free_space_in_bytes",
+}
+
+// keyFields are the fields identifying an item, in order of preference.
+// Items without any are keyed by their name and locator fields
+var keyFields = []string{
+	"path",
+	"spext_path",
+	"serial_num",
+	"device_serial",
+	"serial_number",
+	"bsd_name",
+	"spext_bundleid",
+	"bundle_id",
+	"CFBundleIdentifier",
+}
+
+// locatorFields tell apart items that share a key, such as two identical USB
+// receivers without a serial number
+var locatorFields = []string{
+	"location_id",
+	"volume_uuid",
+	"device_guid",
+	"device_address",
+	"vendor_id",
+	"product_id",
+}
+
+// Snapshots compares every data type of two snapshots.
+func Snapshots(a, b *snapshot.Snapshot, opts Options) (*Report, error) {
+	types := map[snapshot.SPDataType]bool{}
+	for spType := range a.Data {
+		types[spType] = true
+	}
+	for spType := range b.Data {
+		types[spType] = true
+	}
+
+	report := &Report{Changes: []Change{}}
+	for _, spType := range sortedTypes(types) {
+		before, inA := a.Data[spType]
+		after, inB := b.Data[spType]
+		switch {
+		case !inA:
+			report.Changes = append(report.Changes, Change{Type: spType, Kind: Added})
+			continue
+		case !inB:
+			report.Changes = append(report.Changes, Change{Type: spType, Kind: Removed})
+			continue
+		}
+
+		changes, err := compareRaw(spType, before, after, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s: %w", spType, err)
+		}
+		report.Changes = append(report.Changes, changes...)
+	}
+	return report, nil
+}
+
+// Values compares two values of the same type package, such as two
+// *common.DataType[audio.DataTypeItem] or two []applications.DataTypeItem.
+func Values(spType snapshot.SPDataType, a, b interface{}, opts Options) ([]Change, error) {
+	before, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal value: %w", err)
+	}
+	after, err := json.Marshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal value: %w", err)
+	}
+	return compareRaw(spType, before, after, opts)
+}
+
+// compareRaw compares two JSON encoded values of a data type
+func compareRaw(spType snapshot.SPDataType, a, b []byte, opts Options) ([]Change, error) {
+	before, err := flatten(a)
+	if err != nil {
+		return nil, err
+	}
+	after, err := flatten(b)
+	if err != nil {
+		return nil, err
+	}
+
+	ignore := map[string]bool{}
+	for _, field := range opts.Ignore {
+		ignore[field] = true
+	}
+
+	keys := map[string]bool{}
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	changes := []Change{}
+	for _, key := range sorted {
+		x, inBefore := before[key]
+		y, inAfter := after[key]
+		switch {
+		case !inBefore:
+			changes = append(changes, Change{Type: spType, Kind: Added, Key: key, Name: nameOf(y)})
+		case !inAfter:
+			changes = append(changes, Change{Type: spType, Kind: Removed, Key: key, Name: nameOf(x)})
+		default:
+			var fields []FieldChange
+			compareFields("", x, y, ignore, &fields)
+			if len(fields) > 0 {
+				changes = append(changes, Change{Type: spType, Kind: Modified, Key: key, Name: nameOf(y), Fields: fields})
+			}
+		}
+	}
+	return changes, nil
+}
+
+// compareFields appends the differences between two objects to fields
+func compareFields(prefix string, a, b map[string]interface{}, ignore map[string]bool, fields *[]FieldChange) {
+	names := map[string]bool{}
+	for name := range a {
+		names[name] = true
+	}
+	for name := range b {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		if ignore[name] || ignore[path] {
+			continue
+		}
+		x, y := a[name], b[name]
+		if reflect.DeepEqual(x, y) {
+			continue
+		}
+		xm, xok := x.(map[string]interface{})
+		ym, yok := y.(map[string]interface{})
+		if xok && yok {
+			compareFields(path, xm, ym, ignore, fields)
+			continue
+		}
+		*fields = append(*fields, FieldChange{Field: path, Before: x, After: y})
+	}
+}
+
+// flatten decodes a data type and returns its items keyed by their identity.
+// The "_items" of an item are flattened into their own entries.
+func flatten(data []byte) (map[string]map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	items := map[string]map[string]interface{}{}
+	switch v := v.(type) {
+	case []interface{}:
+		addItems(items, "", v, true)
+	case map[string]interface{}:
+		addItems(items, "", []interface{}{v}, true)
+	case nil:
+	default:
+		return nil, fmt.Errorf("unexpected JSON value %T", v)
+	}
+	return items, nil
+}
+
+// addItems adds a list of items below parent. Top level entries that only
+// wrap "_items", such as {"_name": "coreaudio_device", "_items": [...]}, are
+// skipped so their children are keyed directly.
+func addItems(items map[string]map[string]interface{}, parent string, list []interface{}, top bool) {
+	var entries []map[string]interface{}
+	for _, entry := range list {
+		item, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if children, ok := item["_items"].([]interface{}); ok && top && isWrapper(item) {
+			addItems(items, parent, children, false)
+			continue
+		}
+		entries = append(entries, item)
+	}
+
+	seen := map[string]int{}
+	for _, item := range entries {
+		key := keyOf(item)
+		seen[key]++
+		if n := seen[key]; n > 1 {
+			key += "#" + strconv.Itoa(n)
+		}
+		if parent != "" {
+			key = parent + "/" + key
+		}
+		children, hasChildren := item["_items"].([]interface{})
+
+		fields := make(map[string]interface{}, len(item))
+		for name, value := range item {
+			if name != "_items" {
+				fields[name] = value
+			}
+		}
+		items[key] = fields
+
+		if hasChildren {
+			addItems(items, key, children, false)
+		}
+	}
+}
+
+// isWrapper reports whether an entry only carries a name and its items
+func isWrapper(item map[string]interface{}) bool {
+	for name := range item {
+		if name != "_name" && name != "_items" {
+			return false
+		}
+	}
+	return true
+}
+
+// keyOf returns the stable identity of an item. Names are not unique, so an
+// item only known by its name, or by nothing, is also keyed by its locator
+// fields, e.g. "USB Receiver@0x01100000"
+func keyOf(item map[string]interface{}) string {
+	for _, field := range keyFields {
+		if value, ok := item[field].(string); ok && strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	name, _ := item["_name"].(string)
+	locator := locatorOf(item)
+	switch {
+	case strings.TrimSpace(name) == "" && locator == "":
+		return "?"
+	case strings.TrimSpace(name) == "":
+		return locator
+	case locator == "":
+		return name
+	}
+	return name + "@" + locator
+}
+
+// locatorOf joins the locator fields of an item, e.g. "0x01100000:0x046d"
+func locatorOf(item map[string]interface{}) string {
+	var parts []string
+	for _, field := range locatorFields {
+		if value, ok := item[field].(string); ok && strings.TrimSpace(value) != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+// nameOf returns the display name of an item
+func nameOf(item map[string]interface{}) string {
+	name, _ := item["_name"].(string)
+	return name
+}
+
+// sortedTypes returns the keys of a set of data types in order
+func sortedTypes(set map[snapshot.SPDataType]bool) []snapshot.SPDataType {
+	types := make([]snapshot.SPDataType, 0, len(set))
+	for spType := range set {
+		types = append(types, spType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
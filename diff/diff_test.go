@@ -0,0 +1,146 @@
+package diff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+	"github.com/samburba/go-system-profiler/v2/type/applications"
+)
+
+const before = `{
+	"SPApplicationsDataType": [
+		{"_name": "Safari", "path": "/Applications/Safari.app", "version": "17.0"},
+		{"_name": "Zoom", "path": "/Applications/zoom.us.app", "version": "5.17"}
+	],
+	"SPUSBDataType": [{
+		"_name": "USB31Bus",
+		"host_controller": "AppleT8112USBXHCI",
+		"_items": [{"_name": "YubiKey", "serial_num": "Y1", "bcd_device": "5.43"}]
+	}],
+	"SPSoftwareDataType": [{"_name": "os_overview", "os_version": "macOS 14.4 (23E214)", "uptime": "up 1:00:00:00"}]
+}`
+
+const after = `{
+	"SPApplicationsDataType": [
+		{"_name": "Zoom", "path": "/Applications/zoom.us.app", "version": "5.17"},
+		{"_name": "Safari", "path": "/Applications/Safari.app", "version": "17.1"},
+		{"_name": "Slack", "path": "/Applications/Slack.app", "version": "4.36"}
+	],
+	"SPUSBDataType": [{
+		"_name": "USB31Bus",
+		"host_controller": "AppleT8112USBXHCI",
+		"_items": [
+			{"_name": "YubiKey", "serial_num": "Y1", "bcd_device": "5.43"},
+			{"_name": "Keyboard", "serial_num": "K9"}
+		]
+	}],
+	"SPSoftwareDataType": [{"_name": "os_overview", "os_version": "macOS 14.4 (23E214)", "uptime": "up 2:00:00:00"}]
+}`
+
+func mustParse(t *testing.T, data string) *snapshot.Snapshot {
+	t.Helper()
+	s, err := snapshot.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to parse snapshot: %v", err)
+	}
+	return s
+}
+
+func TestSnapshots(t *testing.T) {
+	report, err := Snapshots(mustParse(t, before), mustParse(t, after), Options{Ignore: DefaultIgnore})
+	if err != nil {
+		t.Fatalf("Failed to compare: %v", err)
+	}
+
+	want := []struct {
+		kind Kind
+		key  string
+	}{
+		{Modified, "/Applications/Safari.app"},
+		{Added, "/Applications/Slack.app"},
+		{Added, "USB31Bus/K9"},
+	}
+	if len(report.Changes) != len(want) {
+		t.Fatalf("Expected %d changes, got %+v", len(want), report.Changes)
+	}
+	for i, w := range want {
+		if c := report.Changes[i]; c.Kind != w.kind || c.Key != w.key {
+			t.Errorf("Change %d = %s %s, want %s %s", i, c.Kind, c.Key, w.kind, w.key)
+		}
+	}
+	if f := report.Changes[0].Fields; len(f) != 1 || f[0].Field != "version" || f[0].After != "17.1" {
+		t.Errorf("Unexpected field changes: %+v", f)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteText(&buf, report); err != nil {
+		t.Fatalf("Failed to render: %v", err)
+	}
+	for _, line := range []string{"~ Safari (/Applications/Safari.app)", "version: 17.0 -> 17.1", "+ Keyboard (USB31Bus/K9)"} {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("Expected %q in:\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestInsertUnkeyed(t *testing.T) {
+	before := mustParse(t, `{"SPUSBDataType": [{
+		"_name": "USB31Bus",
+		"host_controller": "AppleT8112USBXHCI",
+		"_items": [
+			{"_name": "USB Receiver", "location_id": "0x01100000", "vendor_id": "0x046d", "bcd_device": "12.03"},
+			{"_name": "USB Receiver", "location_id": "0x01200000", "vendor_id": "0x046d", "bcd_device": "12.03"},
+			{"_name": "Hub", "_items": [{"_name": "Dock"}]}
+		]
+	}]}`)
+	after := mustParse(t, `{"SPUSBDataType": [{
+		"_name": "USB31Bus",
+		"host_controller": "AppleT8112USBXHCI",
+		"_items": [
+			{"_name": "USB Receiver", "location_id": "0x01300000", "vendor_id": "0x046d", "bcd_device": "12.03"},
+			{"_name": "USB Receiver", "location_id": "0x01100000", "vendor_id": "0x046d", "bcd_device": "12.03"},
+			{"_name": "USB Receiver", "location_id": "0x01200000", "vendor_id": "0x046d", "bcd_device": "12.10"},
+			{"_name": "Hub", "_items": [{"_name": "Dock"}]}
+		]
+	}]}`)
+
+	report, err := Snapshots(before, after, Options{})
+	if err != nil {
+		t.Fatalf("Failed to compare: %v", err)
+	}
+	want := []struct {
+		kind Kind
+		key  string
+	}{
+		{Modified, "USB31Bus/USB Receiver@0x01200000:0x046d"},
+		{Added, "USB31Bus/USB Receiver@0x01300000:0x046d"},
+	}
+	if len(report.Changes) != len(want) {
+		t.Fatalf("Expected %d changes, got %+v", len(want), report.Changes)
+	}
+	for i, w := range want {
+		if c := report.Changes[i]; c.Kind != w.kind || c.Key != w.key {
+			t.Errorf("Change %d = %s %s, want %s %s", i, c.Kind, c.Key, w.kind, w.key)
+		}
+	}
+}
+
+func TestValues(t *testing.T) {
+	a := []applications.DataTypeItem{{Name: "Xcode", Path: "/Applications/Xcode.app", Version: "15.3"}}
+	b := []applications.DataTypeItem{{Name: "Xcode", Path: "/Applications/Xcode.app", Version: "15.4"}}
+
+	changes, err := Values(common.SPApplicationsDataType, a, b, Options{})
+	if err != nil {
+		t.Fatalf("Failed to compare: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Kind != Modified || changes[0].Fields[0].Before != "15.3" {
+		t.Errorf("Unexpected changes: %+v", changes)
+	}
+
+	if changes, _ := Values(common.SPApplicationsDataType, a, a, Options{}); len(changes) != 0 {
+		t.Errorf("Expected no changes, got %+v", changes)
+	}
+}
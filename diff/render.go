@@ -0,0 +1,89 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteJSON writes the report as indented JSON.
+func WriteJSON(w io.Writer, r *Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteText writes the report for humans, grouped by data type:
+//
+//	SPApplicationsDataType
+//	  + Slack (/Applications/Slack.app)
+//	  ~ Safari (/Applications/Safari.app)
+//	      version: 17.0 -> 17.1
+func WriteText(w io.Writer, r *Report) error {
+	if r.Empty() {
+		_, err := fmt.Fprintln(w, "No changes")
+		return err
+	}
+
+	var current string
+	for _, c := range r.Changes {
+		if string(c.Type) != current {
+			if current != "" {
+				fmt.Fprintln(w)
+			}
+			current = string(c.Type)
+			fmt.Fprintln(w, current)
+		}
+
+		if c.Key == "" {
+			fmt.Fprintf(w, "  %s data type %s\n", symbol(c.Kind), c.Kind)
+			continue
+		}
+		fmt.Fprintf(w, "  %s %s\n", symbol(c.Kind), label(c))
+		for _, f := range c.Fields {
+			fmt.Fprintf(w, "      %s: %s -> %s\n", f.Field, value(f.Before), value(f.After))
+		}
+	}
+	return nil
+}
+
+// symbol returns the marker shown in front of a change
+func symbol(kind Kind) string {
+	switch kind {
+	case Added:
+		return "+"
+	case Removed:
+		return "-"
+	}
+	return "~"
+}
+
+// label names the changed item, adding its key when it differs from the name
+func label(c Change) string {
+	last := c.Key
+	if i := strings.LastIndexByte(last, '/'); i >= 0 && !strings.HasPrefix(last, "/") {
+		last = last[i+1:]
+	}
+	if c.Name == "" || c.Name == c.Key || c.Name == last {
+		return c.Key
+	}
+	return fmt.Sprintf("%s (%s)", c.Name, c.Key)
+}
+
+// value formats a field value for text output
+func value(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "(none)"
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,78 @@
+// Package disk provides the drive and volume structures shared by the storage
+// bus data types (NVMe, Serial ATA, SAS, Fibre Channel, Parallel ATA, Parallel
+// SCSI and card readers), so that drives look the same whatever bus they are
+// attached to.
+package disk
+
+import "github.com/samburba/go-system-profiler/v2/normalize"
+
+// Volume represents a volume on a drive.
+type Volume struct {
+	Name             string `json:"_name"`
+	BsdName          string `json:"bsd_name,omitempty"`
+	FileSystem       string `json:"file_system,omitempty"`
+	FreeSpace        string `json:"free_space,omitempty"`
+	FreeSpaceInBytes int64  `json:"free_space_in_bytes,omitempty"`
+	IOContent        string `json:"iocontent,omitempty"`
+	MountPoint       string `json:"mount_point,omitempty"`
+	Size             string `json:"size,omitempty"`
+	SizeInBytes      int64  `json:"size_in_bytes,omitempty"`
+	VolumeUUID       string `json:"volume_uuid,omitempty"`
+	Writable         string `json:"writable,omitempty"`
+}
+
+// Capacity returns the volume size, preferring the exact byte count.
+func (v Volume) Capacity() (normalize.ByteSize, error) {
+	if v.SizeInBytes > 0 {
+		return normalize.ByteSize(v.SizeInBytes), nil
+	}
+	return normalize.ParseByteSize(v.Size)
+}
+
+// Free returns the volume free space, preferring the exact byte count.
+func (v Volume) Free() (normalize.ByteSize, error) {
+	if v.FreeSpaceInBytes > 0 {
+		return normalize.ByteSize(v.FreeSpaceInBytes), nil
+	}
+	return normalize.ParseByteSize(v.FreeSpace)
+}
+
+// Device represents the fields every storage bus reports for an attached
+// drive. Bus specific packages embed it and add their prefixed fields.
+type Device struct {
+	Name             string   `json:"_name"`
+	BsdName          string   `json:"bsd_name,omitempty"`
+	DetachableDrive  string   `json:"detachable_drive,omitempty"`
+	DeviceModel      string   `json:"device_model,omitempty"`
+	DeviceRevision   string   `json:"device_revision,omitempty"`
+	DeviceSerial     string   `json:"device_serial,omitempty"`
+	PartitionMapType string   `json:"partition_map_type,omitempty"`
+	RemovableMedia   string   `json:"removable_media,omitempty"`
+	Size             string   `json:"size,omitempty"`
+	SizeInBytes      int64    `json:"size_in_bytes,omitempty"`
+	SmartStatus      string   `json:"smart_status,omitempty"`
+	Volumes          []Volume `json:"volumes,omitempty"`
+}
+
+// Capacity returns the drive size, preferring the exact byte count.
+func (d Device) Capacity() (normalize.ByteSize, error) {
+	if d.SizeInBytes > 0 {
+		return normalize.ByteSize(d.SizeInBytes), nil
+	}
+	return normalize.ParseByteSize(d.Size)
+}
+
+// SMARTVerified reports whether the drive's SMART status is verified.
+func (d Device) SMARTVerified() bool {
+	return normalize.Canonical(d.SmartStatus) == normalize.Verified
+}
+
+// IsRemovable reports whether the drive has removable media.
+func (d Device) IsRemovable() bool {
+	return normalize.Bool(d.RemovableMedia)
+}
+
+// IsDetachable reports whether the drive is detachable.
+func (d Device) IsDetachable() bool {
+	return normalize.Bool(d.DetachableDrive)
+}
@@ -0,0 +1,120 @@
+// Package exporter exposes system profiler data as Prometheus metrics.
+//
+// system_profiler can take a minute to run, so an Exporter collects data on a
+// schedule in the background and scrapes are answered from the last result:
+//
+//	e := exporter.New(exporter.Options{Interval: 5 * time.Minute})
+//	go e.Run(ctx)
+//	http.Handle("/metrics", e)
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// DefaultInterval is the time between two collections.
+const DefaultInterval = 5 * time.Minute
+
+// Options controls an Exporter.
+type Options struct {
+	// Interval is the time between two collections. It defaults to
+	// DefaultInterval.
+	Interval time.Duration
+	// Snapshot controls how system_profiler is invoked.
+	Snapshot snapshot.Options
+	// Collect replaces the call to snapshot.Collect, e.g. to serve a saved
+	// snapshot.
+	Collect func(ctx context.Context, opts snapshot.Options, types ...snapshot.SPDataType) (*snapshot.Snapshot, error)
+}
+
+// Exporter serves the metrics of the most recent collection over HTTP.
+type Exporter struct {
+	opts Options
+
+	mu       sync.RWMutex
+	body     []byte
+	success  bool
+	last     time.Time
+	duration time.Duration
+}
+
+// New returns an Exporter for the given options. Call Run to start
+// collecting.
+func New(opts Options) *Exporter {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.Collect == nil {
+		opts.Collect = snapshot.Collect
+	}
+	return &Exporter{opts: opts}
+}
+
+// Run collects data right away and then on every interval until ctx is
+// done.
+func (e *Exporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.opts.Interval)
+	defer ticker.Stop()
+	for {
+		e.Refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh collects data once and updates the served metrics. When the
+// collection fails, the previous metrics are kept and
+// macos_exporter_last_refresh_success is set to 0.
+func (e *Exporter) Refresh(ctx context.Context) error {
+	start := time.Now()
+	s, err := e.opts.Collect(ctx, e.opts.Snapshot, Types...)
+	var buf bytes.Buffer
+	if err == nil {
+		err = WriteMetrics(&buf, s)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.last = start
+	e.duration = time.Since(start)
+	e.success = err == nil
+	if err == nil {
+		e.body = buf.Bytes()
+	}
+	return err
+}
+
+// ServeHTTP writes the metrics of the most recent collection. It never waits
+// for system_profiler.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	e.mu.RLock()
+	body := e.body
+	status := []metric{
+		{name: "macos_exporter_last_refresh_success", help: "Whether the last collection succeeded."},
+		{name: "macos_exporter_last_refresh_timestamp_seconds", help: "Unix time of the last collection."},
+		{name: "macos_exporter_last_refresh_duration_seconds", help: "Time the last collection took."},
+	}
+	if !e.last.IsZero() {
+		status[0].add(boolValue(e.success))
+		status[1].add(float64(e.last.UnixNano()) / 1e9)
+		status[2].add(e.duration.Seconds())
+	} else {
+		status[0].add(0)
+	}
+	e.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if _, err := w.Write(body); err != nil {
+		return
+	}
+	_ = writeMetrics(w, status)
+}
@@ -0,0 +1,107 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+const fixture = `{
+	"SPApplicationsDataType": [
+		{"_name": "Safari", "obtained_from": "apple"},
+		{"_name": "Xcode", "obtained_from": "apple"},
+		{"_name": "Slack", "obtained_from": "identified_developer"}
+	],
+	"SPFirewallDataType": [{"_name": "firewall_settings", "spfirewall_globalstate": "spfirewall_globalstate_limit_connections", "spfirewall_stealthenabled": "Yes"}],
+	"SPHardwareDataType": [{"_name": "hardware_overview", "machine_model": "Mac15,3", "machine_name": "MacBook Pro", "chip_type": "Apple M3", "physical_memory": "16 GB"}],
+	"SPNVMeDataType": [{"_name": "Apple SSD Controller", "_items": [{"_name": "APPLE SSD AP0512Z", "bsd_name": "disk0", "smart_status": "Verified"}]}],
+	"SPPowerDataType": [{
+		"_name": "spbattery_information",
+		"sppower_battery_health_info": {"sppower_battery_cycle_count": 312, "sppower_battery_health": "Good", "sppower_battery_health_maximum_capacity": "87%"}
+	}],
+	"SPSoftwareDataType": [{"_name": "os_overview", "os_version": "macOS 14.5 (23F79)", "kernel_version": "Darwin 23.5.0", "uptime": "up 1:02:00:00"}],
+	"SPStorageDataType": [{"_name": "Macintosh HD", "bsd_name": "disk3s1s1", "mount_point": "/", "file_system": "APFS", "free_space_in_bytes": 1000, "size_in_bytes": 5000}]
+}`
+
+func TestWriteMetrics(t *testing.T) {
+	s, err := snapshot.Parse([]byte(fixture))
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+	var b strings.Builder
+	if err := WriteMetrics(&b, s); err != nil {
+		t.Fatalf("Failed to write metrics: %v", err)
+	}
+
+	for _, line := range []string{
+		`macos_info{os_version="14.5.0",os_build="23F79",kernel_version="Darwin 23.5.0",model_name="MacBook Pro",model_identifier="Mac15,3",chip="Apple M3"} 1`,
+		`macos_battery_cycle_count 312`,
+		`macos_battery_healthy{condition="Good"} 1`,
+		`macos_battery_maximum_capacity_ratio 0.87`,
+		`macos_storage_free_bytes{volume="Macintosh HD",bsd_name="disk3s1s1",mount_point="/",file_system="APFS"} 1000`,
+		`macos_memory_bytes 1.7179869184e+10`,
+		`macos_uptime_seconds 93600`,
+		`macos_firewall_enabled{state="AllowSpecific"} 1`,
+		`macos_nvme_smart_verified{device="APPLE SSD AP0512Z",bsd_name="disk0",status="Verified"} 1`,
+		`macos_applications{obtained_from="apple"} 2`,
+		`# TYPE macos_applications gauge`,
+	} {
+		if !strings.Contains(b.String(), line+"\n") {
+			t.Errorf("Expected %q in:\n%s", line, b.String())
+		}
+	}
+}
+
+func TestWriteMetricsEscaping(t *testing.T) {
+	m := metric{name: "macos_test", help: "Path C:\\temp\nsecond line \"quoted\"."}
+	m.add(1, "volume", "Disk \"A\"\\B\nC")
+	var b strings.Builder
+	if err := writeMetrics(&b, []metric{m}); err != nil {
+		t.Fatalf("Failed to write metrics: %v", err)
+	}
+	want := "# HELP macos_test Path C:\\\\temp\\nsecond line \"quoted\".\n" +
+		"# TYPE macos_test gauge\n" +
+		"macos_test{volume=\"Disk \\\"A\\\"\\\\B\\nC\"} 1\n"
+	if b.String() != want {
+		t.Errorf("Unexpected exposition:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	calls := 0
+	e := New(Options{Collect: func(context.Context, snapshot.Options, ...snapshot.SPDataType) (*snapshot.Snapshot, error) {
+		calls++
+		if calls > 1 {
+			return nil, errors.New("system_profiler failed")
+		}
+		return snapshot.Parse([]byte(fixture))
+	}})
+
+	scrape := func() string {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+		body, _ := io.ReadAll(rec.Body)
+		return string(body)
+	}
+
+	if body := scrape(); !strings.Contains(body, "macos_exporter_last_refresh_success 0\n") || strings.Contains(body, "macos_info") {
+		t.Errorf("Expected only exporter metrics before the first collection:\n%s", body)
+	}
+	if err := e.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if body := scrape(); !strings.Contains(body, "macos_exporter_last_refresh_success 1\n") || !strings.Contains(body, "macos_battery_cycle_count 312\n") {
+		t.Errorf("Expected metrics after a collection:\n%s", body)
+	}
+	if err := e.Refresh(context.Background()); err == nil {
+		t.Fatal("Expected the second refresh to fail")
+	}
+	if body := scrape(); !strings.Contains(body, "macos_exporter_last_refresh_success 0\n") || !strings.Contains(body, "macos_battery_cycle_count 312\n") {
+		t.Errorf("Expected the previous metrics to be kept after a failure:\n%s", body)
+	}
+}
@@ -0,0 +1,297 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+	"github.com/samburba/go-system-profiler/v2/type/applications"
+	"github.com/samburba/go-system-profiler/v2/type/firewall"
+	"github.com/samburba/go-system-profiler/v2/type/hardware"
+	"github.com/samburba/go-system-profiler/v2/type/nvme"
+	"github.com/samburba/go-system-profiler/v2/type/power"
+	"github.com/samburba/go-system-profiler/v2/type/software"
+	"github.com/samburba/go-system-profiler/v2/type/storage"
+)
+
+// Types lists the data types the exporter collects.
+var Types = []snapshot.SPDataType{
+	common.SPApplicationsDataType,
+	common.SPFirewallDataType,
+	common.SPHardwareDataType,
+	common.SPNVMeDataType,
+	common.SPPowerDataType,
+	common.SPSoftwareDataType,
+	common.SPStorageDataType,
+}
+
+// metric is a gauge with its samples
+type metric struct {
+	name    string
+	help    string
+	samples []sample
+}
+
+// sample is one labelled value of a metric
+type sample struct {
+	labels []label
+	value  float64
+}
+
+// label is a label name and value
+type label struct {
+	name, value string
+}
+
+// add appends a sample with labels given as name, value pairs
+func (m *metric) add(value float64, labels ...string) {
+	s := sample{value: value}
+	for i := 0; i+1 < len(labels); i += 2 {
+		s.labels = append(s.labels, label{labels[i], labels[i+1]})
+	}
+	m.samples = append(m.samples, s)
+}
+
+// WriteMetrics writes the metrics for a snapshot in the Prometheus text
+// exposition format. Data types missing from the snapshot, or that cannot be
+// decoded, are skipped.
+func WriteMetrics(w io.Writer, s *snapshot.Snapshot) error {
+	return writeMetrics(w, metricsOf(s))
+}
+
+// metricsOf returns the metrics for a snapshot
+func metricsOf(s *snapshot.Snapshot) []metric {
+	var metrics []metric
+	for _, fn := range []func(*snapshot.Snapshot) []metric{
+		infoMetrics,
+		batteryMetrics,
+		storageMetrics,
+		memoryMetrics,
+		uptimeMetrics,
+		firewallMetrics,
+		nvmeMetrics,
+		applicationMetrics,
+	} {
+		metrics = append(metrics, fn(s)...)
+	}
+	return metrics
+}
+
+// infoMetrics describes the operating system and hardware in labels
+func infoMetrics(s *snapshot.Snapshot) []metric {
+	hw, _ := snapshot.Items[hardware.DataTypeItem](s, common.SPHardwareDataType)
+	sw, _ := snapshot.Items[software.DataTypeItem](s, common.SPSoftwareDataType)
+	if len(hw) == 0 && len(sw) == 0 {
+		return nil
+	}
+
+	var osVersion, osBuild, kernel, modelName, modelID, chip string
+	if len(sw) > 0 {
+		kernel = sw[0].KernelVersion
+		if v, err := sw[0].ParsedOSVersion(); err == nil {
+			osVersion = fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+			osBuild = v.Build
+		}
+	}
+	if len(hw) > 0 {
+		modelName, modelID, chip = hw[0].MachineName, hw[0].MachineModel, hw[0].ChipType
+	}
+
+	m := metric{name: "macos_info", help: "Operating system and hardware of the Mac; the value is always 1."}
+	m.add(1, "os_version", osVersion, "os_build", osBuild, "kernel_version", kernel,
+		"model_name", modelName, "model_identifier", modelID, "chip", chip)
+	return []metric{m}
+}
+
+// batteryMetrics reports the battery cycle count and health
+func batteryMetrics(s *snapshot.Snapshot) []metric {
+	items, err := snapshot.Items[power.DataTypeItem](s, common.SPPowerDataType)
+	if err != nil {
+		return nil
+	}
+	battery, ok := power.Battery(items)
+	if !ok || battery.HealthInfo == nil {
+		return nil
+	}
+
+	cycles := metric{name: "macos_battery_cycle_count", help: "Charge cycles of the battery."}
+	cycles.add(float64(battery.CycleCount()))
+	healthy := metric{name: "macos_battery_healthy", help: "Whether the battery condition is good (1) or needs service (0)."}
+	healthy.add(boolValue(battery.Healthy()), "condition", battery.HealthInfo.Health)
+	metrics := []metric{cycles, healthy}
+
+	if percent, err := battery.MaximumCapacityPercent(); err == nil {
+		capacity := metric{name: "macos_battery_maximum_capacity_ratio", help: "Capacity of the battery relative to its design capacity."}
+		capacity.add(float64(percent) / 100)
+		metrics = append(metrics, capacity)
+	}
+	return metrics
+}
+
+// storageMetrics reports the size and free space of every volume
+func storageMetrics(s *snapshot.Snapshot) []metric {
+	volumes, err := snapshot.Items[storage.DataTypeItem](s, common.SPStorageDataType)
+	if err != nil || len(volumes) == 0 {
+		return nil
+	}
+
+	free := metric{name: "macos_storage_free_bytes", help: "Free space of the volume in bytes."}
+	size := metric{name: "macos_storage_size_bytes", help: "Size of the volume in bytes."}
+	for _, v := range volumes {
+		labels := []string{"volume", v.Name, "bsd_name", v.BsdName, "mount_point", v.MountPoint, "file_system", v.FileSystem}
+		if n, err := v.Free(); err == nil {
+			free.add(float64(n.Bytes()), labels...)
+		}
+		if n, err := v.Capacity(); err == nil {
+			size.add(float64(n.Bytes()), labels...)
+		}
+	}
+	return []metric{free, size}
+}
+
+// memoryMetrics reports the installed memory
+func memoryMetrics(s *snapshot.Snapshot) []metric {
+	items, err := snapshot.Items[hardware.DataTypeItem](s, common.SPHardwareDataType)
+	if err != nil || len(items) == 0 {
+		return nil
+	}
+	size, err := items[0].PhysicalMemoryBytes()
+	if err != nil {
+		return nil
+	}
+	m := metric{name: "macos_memory_bytes", help: "Installed physical memory in bytes."}
+	m.add(float64(size.Bytes()))
+	return []metric{m}
+}
+
+// uptimeMetrics reports the time since boot
+func uptimeMetrics(s *snapshot.Snapshot) []metric {
+	items, err := snapshot.Items[software.DataTypeItem](s, common.SPSoftwareDataType)
+	if err != nil || len(items) == 0 {
+		return nil
+	}
+	uptime, err := items[0].UptimeDuration()
+	if err != nil {
+		return nil
+	}
+	m := metric{name: "macos_uptime_seconds", help: "Time since boot when the data was collected."}
+	m.add(uptime.Seconds())
+	return []metric{m}
+}
+
+// firewallMetrics reports the application firewall state
+func firewallMetrics(s *snapshot.Snapshot) []metric {
+	items, err := snapshot.Items[firewall.DataTypeItem](s, common.SPFirewallDataType)
+	if err != nil || len(items) == 0 {
+		return nil
+	}
+	state := items[0].GlobalState()
+	enabled := metric{name: "macos_firewall_enabled", help: "Whether the application firewall is enabled."}
+	enabled.add(boolValue(items[0].Enabled()), "state", state.String())
+	stealth := metric{name: "macos_firewall_stealth_enabled", help: "Whether firewall stealth mode is enabled."}
+	stealth.add(boolValue(items[0].StealthEnabled()))
+	return []metric{enabled, stealth}
+}
+
+// nvmeMetrics reports the SMART status of every NVMe drive
+func nvmeMetrics(s *snapshot.Snapshot) []metric {
+	controllers, err := snapshot.Items[nvme.DataTypeItem](s, common.SPNVMeDataType)
+	if err != nil {
+		return nil
+	}
+	m := metric{name: "macos_nvme_smart_verified", help: "Whether the SMART status of the NVMe drive is verified."}
+	for _, controller := range controllers {
+		for _, d := range controller.Items {
+			m.add(boolValue(d.SMARTVerified()), "device", d.Name, "bsd_name", d.BsdName, "status", d.SmartStatus)
+		}
+	}
+	if len(m.samples) == 0 {
+		return nil
+	}
+	return []metric{m}
+}
+
+// applicationMetrics counts the installed applications by origin
+func applicationMetrics(s *snapshot.Snapshot) []metric {
+	apps, err := snapshot.Items[applications.DataTypeItem](s, common.SPApplicationsDataType)
+	if err != nil {
+		return nil
+	}
+	counts := map[string]int{}
+	for _, app := range apps {
+		origin := app.ObtainedFrom
+		if origin == "" {
+			origin = "unknown"
+		}
+		counts[origin]++
+	}
+	origins := make([]string, 0, len(counts))
+	for origin := range counts {
+		origins = append(origins, origin)
+	}
+	sort.Strings(origins)
+
+	m := metric{name: "macos_applications", help: "Installed applications by origin."}
+	for _, origin := range origins {
+		m.add(float64(counts[origin]), "obtained_from", origin)
+	}
+	return []metric{m}
+}
+
+// boolValue converts a boolean to a gauge value
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// writeMetrics writes metrics in the Prometheus text exposition format
+func writeMetrics(w io.Writer, metrics []metric) error {
+	var b strings.Builder
+	for _, m := range metrics {
+		if len(m.samples) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s gauge\n", m.name, escapeHelp(m.help), m.name)
+		for _, s := range m.samples {
+			b.WriteString(m.name)
+			if len(s.labels) > 0 {
+				b.WriteByte('{')
+				for i, l := range s.labels {
+					if i > 0 {
+						b.WriteByte(',')
+					}
+					fmt.Fprintf(&b, "%s=\"%s\"", l.name, escapeLabel(l.value))
+				}
+				b.WriteByte('}')
+			}
+			b.WriteByte(' ')
+			b.WriteString(strconv.FormatFloat(s.value, 'g', -1, 64))
+			b.WriteByte('\n')
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// labelEscaper escapes label values as required by the exposition format
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabel escapes a label value
+func escapeLabel(value string) string {
+	return labelEscaper.Replace(value)
+}
+
+// helpEscaper escapes HELP text, where only backslashes and line feeds are
+// escaped
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+// escapeHelp escapes HELP text
+func escapeHelp(help string) string {
+	return helpEscaper.Replace(help)
+}
@@ -1,9 +1,12 @@
 package common
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"strconv"
+	"time"
 )
 
 // DataType represents the structure for items-based data (like Audio)
@@ -42,6 +45,19 @@ func (d ObjectDataType[T]) String() string {
 	return string(jsonData)
 }
 
+// Decode converts the object into its typed item structure
+func (d ObjectDataType[T]) Decode() (T, error) {
+	var item T
+	jsonData, err := json.Marshal(d)
+	if err != nil {
+		return item, fmt.Errorf("failed to marshal object data: %w", err)
+	}
+	if err := json.Unmarshal(jsonData, &item); err != nil {
+		return item, fmt.Errorf("failed to decode object data: %w", err)
+	}
+	return item, nil
+}
+
 // NewData creates a DataType for items-based structures
 func NewData[T any](spType SPDataType) (*DataType[T], error) {
 	d, err := executeSPCommand[T](spType)
@@ -51,6 +67,16 @@ func NewData[T any](spType SPDataType) (*DataType[T], error) {
 	return d, nil
 }
 
+// NewEntriesData creates a DataType holding every top-level entry, for data
+// types whose entries carry their own _items (like NVMe controllers)
+func NewEntriesData[T any](spType SPDataType) (*DataType[T], error) {
+	d, err := executeEntriesSPCommand[T](spType)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
 // NewDirectData creates a DirectDataType for direct array structures
 func NewDirectData[T any](spType SPDataType) (DirectDataType[T], error) {
 	d, err := executeDirectSPCommand[T](spType)
@@ -69,18 +95,59 @@ func NewObjectData[T any](spType SPDataType) (ObjectDataType[T], error) {
 	return d, nil
 }
 
-// executeSPCommand handles items-based structures (like Audio)
-func executeSPCommand[T any](spType SPDataType) (*DataType[T], error) {
-	cmd := exec.Command("system_profiler", string(spType), "-json")
+// DetailLevel controls how much information system_profiler reports
+type DetailLevel string
+
+const (
+	DetailMini  DetailLevel = "mini"
+	DetailBasic DetailLevel = "basic"
+	DetailFull  DetailLevel = "full"
+)
+
+// Options controls how system_profiler is invoked
+type Options struct {
+	DetailLevel DetailLevel
+	Timeout     time.Duration
+}
+
+// args returns the command line arguments for the given options and types
+func (o Options) args(spTypes []SPDataType) []string {
+	args := make([]string, 0, len(spTypes)+5)
+	for _, spType := range spTypes {
+		args = append(args, string(spType))
+	}
+	args = append(args, "-json")
+	if o.DetailLevel != "" {
+		args = append(args, "-detailLevel", string(o.DetailLevel))
+	}
+	if o.Timeout > 0 {
+		seconds := int(o.Timeout.Round(time.Second) / time.Second)
+		if seconds < 1 {
+			seconds = 1
+		}
+		args = append(args, "-timeout", strconv.Itoa(seconds))
+	}
+	return args
+}
+
+// Run executes system_profiler once for all given types and returns its raw JSON output
+func Run(ctx context.Context, opts Options, spTypes ...SPDataType) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "system_profiler", opts.args(spTypes)...)
 	output, err := cmd.Output()
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("failed to execute command: %w", ctxErr)
+		}
 		return nil, fmt.Errorf("failed to execute command: %w", err)
 	}
+	return output, nil
+}
 
-	// Define the structure based on the data you expect
+// ParseData decodes items-based system_profiler JSON output (like Audio)
+func ParseData[T any](spType SPDataType, output []byte) (*DataType[T], error) {
 	var rawData map[string][]DataType[T]
 
-	err = json.Unmarshal(output, &rawData)
+	err := json.Unmarshal(output, &rawData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
@@ -92,17 +159,21 @@ func executeSPCommand[T any](spType SPDataType) (*DataType[T], error) {
 	return nil, fmt.Errorf("no data found for %s", spType)
 }
 
-// executeDirectSPCommand handles direct array structures (like Applications)
-func executeDirectSPCommand[T any](spType SPDataType) (DirectDataType[T], error) {
-	cmd := exec.Command("system_profiler", string(spType), "-json")
-	output, err := cmd.Output()
+// ParseEntriesData decodes system_profiler JSON output whose top-level entries
+// carry their own _items (like NVMe controllers). Name is set to the data type
+func ParseEntriesData[T any](spType SPDataType, output []byte) (*DataType[T], error) {
+	items, err := ParseDirectData[T](spType, output)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute command: %w", err)
+		return nil, err
 	}
+	return &DataType[T]{Item: items, Name: string(spType)}, nil
+}
 
+// ParseDirectData decodes direct array system_profiler JSON output (like Applications)
+func ParseDirectData[T any](spType SPDataType, output []byte) (DirectDataType[T], error) {
 	var rawData map[string]DirectDataType[T]
 
-	err = json.Unmarshal(output, &rawData)
+	err := json.Unmarshal(output, &rawData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
@@ -114,17 +185,11 @@ func executeDirectSPCommand[T any](spType SPDataType) (DirectDataType[T], error)
 	return nil, fmt.Errorf("no data found for %s", spType)
 }
 
-// executeObjectSPCommand handles object structures (like Network, Bluetooth)
-func executeObjectSPCommand[T any](spType SPDataType) (ObjectDataType[T], error) {
-	cmd := exec.Command("system_profiler", string(spType), "-json")
-	output, err := cmd.Output()
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute command: %w", err)
-	}
-
+// ParseObjectData decodes object system_profiler JSON output (like Network, Bluetooth)
+func ParseObjectData[T any](spType SPDataType, output []byte) (ObjectDataType[T], error) {
 	var rawData map[string][]ObjectDataType[T]
 
-	err = json.Unmarshal(output, &rawData)
+	err := json.Unmarshal(output, &rawData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
@@ -135,3 +200,40 @@ func executeObjectSPCommand[T any](spType SPDataType) (ObjectDataType[T], error)
 
 	return nil, fmt.Errorf("no data found for %s", spType)
 }
+
+// executeSPCommand handles items-based structures (like Audio)
+func executeSPCommand[T any](spType SPDataType) (*DataType[T], error) {
+	output, err := Run(context.Background(), Options{}, spType)
+	if err != nil {
+		return nil, err
+	}
+	return ParseData[T](spType, output)
+}
+
+// executeEntriesSPCommand handles structures whose entries carry their own
+// _items (like NVMe controllers)
+func executeEntriesSPCommand[T any](spType SPDataType) (*DataType[T], error) {
+	output, err := Run(context.Background(), Options{}, spType)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEntriesData[T](spType, output)
+}
+
+// executeDirectSPCommand handles direct array structures (like Applications)
+func executeDirectSPCommand[T any](spType SPDataType) (DirectDataType[T], error) {
+	output, err := Run(context.Background(), Options{}, spType)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDirectData[T](spType, output)
+}
+
+// executeObjectSPCommand handles object structures (like Network, Bluetooth)
+func executeObjectSPCommand[T any](spType SPDataType) (ObjectDataType[T], error) {
+	output, err := Run(context.Background(), Options{}, spType)
+	if err != nil {
+		return nil, err
+	}
+	return ParseObjectData[T](spType, output)
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timestampLayouts lists the date formats system_profiler uses across data types
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"1/2/06, 3:04 PM",
+	"2006-01-02",
+}
+
+// ParseTimestamp parses a system_profiler date string
+func ParseTimestamp(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", value)
+}
@@ -0,0 +1,40 @@
+package normalize
+
+import "strings"
+
+// ArchKind is the arch_kind value system_profiler reports for executables,
+// frameworks and legacy software.
+type ArchKind string
+
+// Architectures reported in arch_kind.
+const (
+	ArchAppleSilicon ArchKind = "arch_arm"
+	ArchUniversal    ArchKind = "arch_arm_i64"
+	ArchIntel64      ArchKind = "arch_i64"
+	ArchIntel32      ArchKind = "arch_i32"
+	ArchIntel32And64 ArchKind = "arch_i32_i64"
+	ArchPowerPC      ArchKind = "arch_ppc"
+	ArchOther        ArchKind = "arch_other"
+)
+
+// HasARM reports whether the executable contains Apple Silicon code.
+func (a ArchKind) HasARM() bool {
+	return strings.Contains(string(a), "arm")
+}
+
+// HasIntel reports whether the executable contains Intel code.
+func (a ArchKind) HasIntel() bool {
+	return strings.Contains(string(a), "i32") || strings.Contains(string(a), "i64")
+}
+
+// IsIntelOnly reports whether the executable needs Rosetta on Apple Silicon.
+func (a ArchKind) IsIntelOnly() bool {
+	return a.HasIntel() && !a.HasARM()
+}
+
+// Is32Bit reports whether the executable only contains 32-bit code and
+// cannot run on macOS 10.15 or later.
+func (a ArchKind) Is32Bit() bool {
+	s := string(a)
+	return (strings.Contains(s, "i32") || strings.Contains(s, "ppc")) && !strings.Contains(s, "64") && !a.HasARM()
+}
@@ -0,0 +1,102 @@
+package normalize
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ByteSize is a size in bytes.
+type ByteSize int64
+
+// Byte size units. Decimal units follow the convention macOS uses for storage.
+const (
+	Byte ByteSize = 1
+	KB   ByteSize = 1000 * Byte
+	MB   ByteSize = 1000 * KB
+	GB   ByteSize = 1000 * MB
+	TB   ByteSize = 1000 * GB
+	PB   ByteSize = 1000 * TB
+
+	KiB ByteSize = 1024 * Byte
+	MiB ByteSize = 1024 * KiB
+	GiB ByteSize = 1024 * MiB
+	TiB ByteSize = 1024 * GiB
+)
+
+// Bytes returns the size as an int64.
+func (b ByteSize) Bytes() int64 {
+	return int64(b)
+}
+
+// String formats the size with decimal units, e.g. "500.28 GB".
+func (b ByteSize) String() string {
+	units := []struct {
+		size ByteSize
+		name string
+	}{{PB, "PB"}, {TB, "TB"}, {GB, "GB"}, {MB, "MB"}, {KB, "KB"}}
+	for _, unit := range units {
+		if b >= unit.size {
+			return strconv.FormatFloat(float64(b)/float64(unit.size), 'f', -1, 64) + " " + unit.name
+		}
+	}
+	return fmt.Sprintf("%d bytes", int64(b))
+}
+
+// ParseByteSize parses storage sizes such as "500.28 GB", "1 TB" or
+// "512 bytes". Units without an "i" are decimal, as macOS reports storage;
+// KiB, MiB, GiB and TiB are binary.
+func ParseByteSize(value string) (ByteSize, error) {
+	return parseByteSize(value, false)
+}
+
+// ParseMemorySize parses memory sizes such as "16 GB" or "8192 MB", which
+// macOS reports with binary multiples.
+func ParseMemorySize(value string) (ByteSize, error) {
+	return parseByteSize(value, true)
+}
+
+// parseByteSize parses value, treating KB/MB/GB/TB as binary when binary is set.
+func parseByteSize(value string, binary bool) (ByteSize, error) {
+	number, unit := splitNumber(value)
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+	unit = strings.ToUpper(strings.TrimSuffix(strings.Fields(unit + " ")[0], "."))
+	var multiplier ByteSize
+	switch unit {
+	case "", "B", "BYTE", "BYTES":
+		multiplier = Byte
+	case "KB", "K":
+		multiplier = pick(binary, KiB, KB)
+	case "MB", "M":
+		multiplier = pick(binary, MiB, MB)
+	case "GB", "G":
+		multiplier = pick(binary, GiB, GB)
+	case "TB", "T":
+		multiplier = pick(binary, TiB, TB)
+	case "PB":
+		multiplier = pick(binary, 1024*TiB, PB)
+	case "KIB":
+		multiplier = KiB
+	case "MIB":
+		multiplier = MiB
+	case "GIB":
+		multiplier = GiB
+	case "TIB":
+		multiplier = TiB
+	default:
+		return 0, fmt.Errorf("unknown size unit in %q", value)
+	}
+	return ByteSize(math.Round(n * float64(multiplier))), nil
+}
+
+// pick returns binary when useBinary is set and decimal otherwise.
+func pick(useBinary bool, binary, decimal ByteSize) ByteSize {
+	if useBinary {
+		return binary
+	}
+	return decimal
+}
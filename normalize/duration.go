@@ -0,0 +1,80 @@
+package normalize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseDuration parses durations such as "up 3:02:05:33" (days, hours,
+// minutes, seconds), "3 days, 2:05", "2:05:10", "2:05" (hours, minutes) or
+// "45 minutes".
+func ParseDuration(value string) (time.Duration, error) {
+	rest := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(value), "up"))
+	if rest == "" {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	var total time.Duration
+	if before, after, found := strings.Cut(rest, ","); found {
+		d, err := parseWords(before)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+		}
+		total, rest = d, strings.TrimSpace(after)
+	}
+	if !strings.Contains(rest, ":") {
+		d, err := parseWords(rest)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+		}
+		return total + d, nil
+	}
+	parts := strings.Split(rest, ":")
+	units := map[int][]time.Duration{
+		2: {time.Hour, time.Minute},
+		3: {time.Hour, time.Minute, time.Second},
+		4: {24 * time.Hour, time.Hour, time.Minute, time.Second},
+	}[len(parts)]
+	if units == nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+		}
+		total += time.Duration(n) * units[i]
+	}
+	return total, nil
+}
+
+// parseWords parses "<n> <unit>" pairs such as "3 days" or "1 hour 5 minutes".
+func parseWords(value string) (time.Duration, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 || len(fields)%2 != 0 {
+		return 0, fmt.Errorf("expected <number> <unit> pairs in %q", value)
+	}
+	var total time.Duration
+	for i := 0; i < len(fields); i += 2 {
+		n, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return 0, err
+		}
+		var unit time.Duration
+		switch word := strings.ToLower(fields[i+1]); {
+		case strings.HasPrefix(word, "day"):
+			unit = 24 * time.Hour
+		case strings.HasPrefix(word, "hour"):
+			unit = time.Hour
+		case strings.HasPrefix(word, "min"):
+			unit = time.Minute
+		case strings.HasPrefix(word, "sec"):
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("unknown unit %q", fields[i+1])
+		}
+		total += time.Duration(n) * unit
+	}
+	return total, nil
+}
@@ -0,0 +1,65 @@
+package normalize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Frequency is a frequency in hertz.
+type Frequency float64
+
+// Frequency units.
+const (
+	Hertz     Frequency = 1
+	Kilohertz Frequency = 1e3
+	Megahertz Frequency = 1e6
+	Gigahertz Frequency = 1e9
+)
+
+// GHz returns the frequency in gigahertz.
+func (f Frequency) GHz() float64 {
+	return float64(f / Gigahertz)
+}
+
+// MHz returns the frequency in megahertz.
+func (f Frequency) MHz() float64 {
+	return float64(f / Megahertz)
+}
+
+// String formats the frequency, e.g. "3.2 GHz".
+func (f Frequency) String() string {
+	switch {
+	case f >= Gigahertz:
+		return strconv.FormatFloat(f.GHz(), 'f', -1, 64) + " GHz"
+	case f >= Megahertz:
+		return strconv.FormatFloat(f.MHz(), 'f', -1, 64) + " MHz"
+	case f >= Kilohertz:
+		return strconv.FormatFloat(float64(f/Kilohertz), 'f', -1, 64) + " kHz"
+	}
+	return strconv.FormatFloat(float64(f), 'f', -1, 64) + " Hz"
+}
+
+// ParseFrequency parses values such as "3.2 GHz", "2400 MHz" or "48000 Hz".
+// A bare number is read as hertz.
+func ParseFrequency(value string) (Frequency, error) {
+	number, unit := splitNumber(value)
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid frequency %q", value)
+	}
+	var multiplier Frequency
+	switch strings.ToLower(unit) {
+	case "", "hz":
+		multiplier = Hertz
+	case "khz":
+		multiplier = Kilohertz
+	case "mhz":
+		multiplier = Megahertz
+	case "ghz":
+		multiplier = Gigahertz
+	default:
+		return 0, fmt.Errorf("unknown frequency unit in %q", value)
+	}
+	return Frequency(n) * multiplier, nil
+}
@@ -0,0 +1,68 @@
+// Package normalize converts the human readable values system_profiler
+// reports, such as "500.28 GB", "attrib_Yes" or "Up to 40 Gb/s", into typed
+// values.
+package normalize
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseBool interprets yes/no style values, including Apple's internal tokens
+// such as "attrib_Yes", "attrib_on", "spfirewall_stealthenabled_yes" or
+// "integrity_enabled" and localized variants such as "Oui" or "Nein".
+func ParseBool(value string) (bool, error) {
+	switch Canonical(value) {
+	case Yes, On, Enabled, Supported:
+		return true, nil
+	case No, Off, Disabled, Unsupported, NotSupported:
+		return false, nil
+	}
+	return false, fmt.Errorf("unrecognized boolean %q", value)
+}
+
+// Bool is like ParseBool but returns false for unrecognized values.
+func Bool(value string) bool {
+	b, _ := ParseBool(value)
+	return b
+}
+
+// splitNumber splits values such as "500.28 GB" or "40Gb/s" into the leading
+// number and the remaining unit.
+func splitNumber(value string) (string, string) {
+	value = strings.TrimSpace(value)
+	end := 0
+	for end < len(value) {
+		c := value[end]
+		if (c < '0' || c > '9') && c != '.' && c != ',' {
+			break
+		}
+		end++
+	}
+	return decimalNumber(value[:end]), strings.TrimSpace(value[end:])
+}
+
+// decimalNumber rewrites a number written with thousands separators or a
+// decimal comma, such as "1,024", "1.024,5" or "251,0", with a decimal point
+// and no grouping. A comma followed by exactly three digits separates
+// thousands; any other comma is a decimal comma.
+func decimalNumber(number string) string {
+	comma, dot := strings.LastIndexByte(number, ','), strings.LastIndexByte(number, '.')
+	switch {
+	case comma < 0:
+		return number
+	case dot > comma:
+		// "1,024.5"
+		return strings.ReplaceAll(number, ",", "")
+	case dot >= 0:
+		// "1.024,5"
+		return strings.Replace(strings.ReplaceAll(number, ".", ""), ",", ".", 1)
+	}
+	groups := strings.Split(number, ",")
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return strings.Replace(number, ",", ".", 1)
+		}
+	}
+	return strings.Join(groups, "")
+}
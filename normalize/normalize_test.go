@@ -0,0 +1,207 @@
+package normalize
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBool(t *testing.T) {
+	cases := map[string]bool{
+		"Yes":                           true,
+		"no":                            false,
+		"attrib_Yes":                    true,
+		"attrib_off":                    false,
+		"spfirewall_stealthenabled_yes": true,
+		"integrity_enabled":             true,
+		"secure_vm_disabled":            false,
+		"spx_supported":                 true,
+		"TRUE":                          true,
+	}
+	for input, want := range cases {
+		got, err := ParseBool(input)
+		if err != nil || got != want {
+			t.Errorf("ParseBool(%q) = %v (%v), want %v", input, got, err, want)
+		}
+	}
+	if _, err := ParseBool("Verified"); err == nil {
+		t.Error("Expected an error for a non-boolean value")
+	}
+	if Bool("maybe") {
+		t.Error("Bool should return false for unrecognized values")
+	}
+	if Bool("spx_not_enabled") || Bool("x_not_enabled") {
+		t.Error("Bool should not read a negated token as true")
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	cases := map[string]ByteSize{
+		"500.28 GB":               500280000000,
+		"1 TB":                    TB,
+		"512 bytes":               512,
+		"4 KiB":                   4096,
+		"251,0 GB":                251 * GB,
+		"1,024 GB":                1024 * GB,
+		"1,024.5 MB":              1024500000,
+		"1.024,5 MB":              1024500000,
+		"2,000,398,934,016 bytes": 2000398934016,
+		"0,5 TB":                  500 * GB,
+	}
+	for input, want := range cases {
+		got, err := ParseByteSize(input)
+		if err != nil || got != want {
+			t.Errorf("ParseByteSize(%q) = %d (%v), want %d", input, got, err, want)
+		}
+	}
+	if got, err := ParseMemorySize("16 GB"); err != nil || got != 16*GiB {
+		t.Errorf("ParseMemorySize(16 GB) = %d (%v), want %d", got, err, 16*GiB)
+	}
+	if _, err := ParseByteSize("lots"); err == nil {
+		t.Error("Expected an error for an invalid size")
+	}
+	if s := ByteSize(500280000000).String(); s != "500.28 GB" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestParseSpeed(t *testing.T) {
+	cases := map[string]Speed{
+		"Up to 40 Gb/s":    40 * GbitPerSecond,
+		"6 Gigabit":        6 * GbitPerSecond,
+		"1000 Mbps":        1000 * MbitPerSecond,
+		"up_to_800_mb_sec": 800 * MbitPerSecond,
+		"480 Mb/s":         480 * MbitPerSecond,
+		"100 MB/s":         800 * MbitPerSecond,
+	}
+	for input, want := range cases {
+		got, err := ParseSpeed(input)
+		if err != nil || got != want {
+			t.Errorf("ParseSpeed(%q) = %v (%v), want %v", input, got, err, want)
+		}
+	}
+	if _, err := ParseSpeed("fast"); err == nil {
+		t.Error("Expected an error for an invalid speed")
+	}
+	if s := (40 * GbitPerSecond).String(); s != "40 Gb/s" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestParseFrequency(t *testing.T) {
+	cases := map[string]Frequency{
+		"3.2 GHz":  3.2 * Gigahertz,
+		"2400 MHz": 2400 * Megahertz,
+		"48000":    48000,
+	}
+	for input, want := range cases {
+		got, err := ParseFrequency(input)
+		if err != nil || got != want {
+			t.Errorf("ParseFrequency(%q) = %v (%v), want %v", input, got, err, want)
+		}
+	}
+	if _, err := ParseFrequency("3 parsecs"); err == nil {
+		t.Error("Expected an error for an unknown unit")
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"up 3:02:05:33":  74*time.Hour + 5*time.Minute + 33*time.Second,
+		"3 days, 2:05":   74*time.Hour + 5*time.Minute,
+		"2:05":           2*time.Hour + 5*time.Minute,
+		"45 minutes":     45 * time.Minute,
+		"1 day, 3 hours": 27 * time.Hour,
+	}
+	for input, want := range cases {
+		got, err := ParseDuration(input)
+		if err != nil || got != want {
+			t.Errorf("ParseDuration(%q) = %v (%v), want %v", input, got, err, want)
+		}
+	}
+	if _, err := ParseDuration("soon"); err == nil {
+		t.Error("Expected an error for an invalid duration")
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	cases := map[string]Version{
+		"14.6.1":                        {Major: 14, Minor: 6, Patch: 1},
+		"macOS 14.6.1 (23G93)":          {Major: 14, Minor: 6, Patch: 1, Build: "23G93"},
+		"macOS 13.4.1 (a) (22F770820d)": {Major: 13, Minor: 4, Patch: 1, RapidSecurityResponse: "a", Build: "22F770820d"},
+		"15.0-beta2":                    {Major: 15, PreRelease: "beta2"},
+		"17.0b3":                        {Major: 17, PreRelease: "b3"},
+		"v2.1.3.4":                      {Major: 2, Minor: 1, Patch: 3, Revision: 4},
+		"SE OS 2.5,":                    {Major: 2, Minor: 5},
+	}
+	for input, want := range cases {
+		got, err := ParseVersion(input)
+		if err != nil || got != want {
+			t.Errorf("ParseVersion(%q) = %+v (%v), want %+v", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"", "latest", "1.2.3.4.5"} {
+		if _, err := ParseVersion(input); err == nil {
+			t.Errorf("ParseVersion(%q) should fail", input)
+		}
+	}
+	if name, _, err := ParseNamedVersion("macOS 14.6.1 (23G93)"); err != nil || name != "macOS" {
+		t.Errorf("ParseNamedVersion() name = %q (%v), want macOS", name, err)
+	}
+
+	// Each version is older than the next one.
+	ordered := []string{"14.6", "15.0-beta2", "15.0-beta10", "15.0-rc1", "15.0 (24A335)", "15.0 (a)", "15.0 (b)", "15.0.1", "15.0.1.1"}
+	for i := 1; i < len(ordered); i++ {
+		a, _ := ParseVersion(ordered[i-1])
+		b, _ := ParseVersion(ordered[i])
+		if a.Compare(b) != -1 || b.Compare(a) != 1 {
+			t.Errorf("Expected %q < %q", ordered[i-1], ordered[i])
+		}
+	}
+	if a, b := (Version{Major: 15, Build: "24A335"}), (Version{Major: 15, Build: "24A336"}); a.Compare(b) != 0 {
+		t.Error("Expected builds not to be compared")
+	}
+	if v, _ := ParseVersion("macOS 13.4.1 (a) (22F770820d)"); v.String() != "13.4.1 (a) (22F770820d)" {
+		t.Errorf("String() = %q", v.String())
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	cases := map[string]string{
+		"Yes":                                    Yes,
+		"Oui":                                    Yes,
+		"attrib_Yes":                             Yes,
+		"spfirewall_globalstate_off":             Off,
+		"Vérifié":                                Verified,
+		"Verified":                               Verified,
+		"coreaudio_device_type_builtin":          BuiltIn,
+		"coreaudio_device_type_usb":              USB,
+		"spdisplays_displayport":                 DisplayPort,
+		"something_new":                          "something_new",
+		"special_mode":                           "special_mode",
+		"spx_not_enabled":                        "spx_not_enabled",
+		"feature_not_supported":                  "feature_not_supported",
+		"Compatible":                             "compatible",
+		"Full Security":                          FullSecurity,
+		"spdisc_burn_support_yes_apple_shipping": Yes,
+	}
+	for input, want := range cases {
+		if got := Canonical(input); got != want {
+			t.Errorf("Canonical(%q) = %q, want %q", input, got, want)
+		}
+	}
+
+	for input, want := range map[string]string{"coreaudio_device_type_usb": USB, "Oui": Yes, "MacBook Pro Speakers": "", "1": "", "14.6.1": ""} {
+		if got, ok := Token(input); got != want || ok != (want != "") {
+			t.Errorf("Token(%q) = %q, %v, want %q", input, got, ok, want)
+		}
+	}
+
+	if ok, err := ParseBool("Désactivé"); err != nil || ok {
+		t.Errorf("ParseBool(Désactivé) = %v (%v), want false", ok, err)
+	}
+
+	RegisterToken("Tak", Yes)
+	if got := Canonical("tak"); got != Yes {
+		t.Errorf("Canonical after RegisterToken = %q, want %q", got, Yes)
+	}
+}
@@ -0,0 +1,82 @@
+package normalize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Speed is a data rate in bits per second.
+type Speed float64
+
+// Speed units.
+const (
+	BitPerSecond  Speed = 1
+	KbitPerSecond Speed = 1e3
+	MbitPerSecond Speed = 1e6
+	GbitPerSecond Speed = 1e9
+)
+
+// Gbps returns the speed in gigabits per second.
+func (s Speed) Gbps() float64 {
+	return float64(s / GbitPerSecond)
+}
+
+// Mbps returns the speed in megabits per second.
+func (s Speed) Mbps() float64 {
+	return float64(s / MbitPerSecond)
+}
+
+// String formats the speed, e.g. "40 Gb/s".
+func (s Speed) String() string {
+	switch {
+	case s >= GbitPerSecond:
+		return strconv.FormatFloat(s.Gbps(), 'f', -1, 64) + " Gb/s"
+	case s >= MbitPerSecond:
+		return strconv.FormatFloat(s.Mbps(), 'f', -1, 64) + " Mb/s"
+	case s >= KbitPerSecond:
+		return strconv.FormatFloat(float64(s/KbitPerSecond), 'f', -1, 64) + " Kb/s"
+	}
+	return strconv.FormatFloat(float64(s), 'f', -1, 64) + " b/s"
+}
+
+// ParseSpeed parses link speeds such as "Up to 40 Gb/s", "6 Gigabit",
+// "1000 Mbps", "up_to_800_mb_sec" or "480 Mb/s". Byte based units written
+// with an uppercase B, such as "MB/s", are converted to bits.
+func ParseSpeed(value string) (Speed, error) {
+	s := strings.ReplaceAll(strings.TrimSpace(value), "_", " ")
+	start := strings.IndexAny(s, "0123456789")
+	if start < 0 {
+		return 0, fmt.Errorf("invalid speed %q", value)
+	}
+	number, unit := splitNumber(s[start:])
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid speed %q", value)
+	}
+	fields := strings.Fields(unit)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("missing speed unit in %q", value)
+	}
+	word := fields[0]
+	var multiplier Speed
+	switch word[0] {
+	case 'T', 't':
+		multiplier = 1e12
+	case 'G', 'g':
+		multiplier = GbitPerSecond
+	case 'M', 'm':
+		multiplier = MbitPerSecond
+	case 'K', 'k':
+		multiplier = KbitPerSecond
+	case 'B', 'b':
+		multiplier = BitPerSecond
+		word = " " + word
+	default:
+		return 0, fmt.Errorf("unknown speed unit in %q", value)
+	}
+	if len(word) > 1 && word[1] == 'B' {
+		multiplier *= 8
+	}
+	return Speed(n) * multiplier, nil
+}
@@ -0,0 +1,167 @@
+package normalize
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Canonical values returned by Canonical. They do not depend on the language
+// of the Mac that produced the data.
+const (
+	Yes          = "yes"
+	No           = "no"
+	On           = "on"
+	Off          = "off"
+	Enabled      = "enabled"
+	Disabled     = "disabled"
+	Supported    = "supported"
+	Unsupported  = "unsupported"
+	Verified     = "verified"
+	Failing      = "failing"
+	NotSupported = "not_supported"
+	BuiltIn      = "builtin"
+	USB          = "usb"
+	Bluetooth    = "bluetooth"
+	Thunderbolt  = "thunderbolt"
+	HDMI         = "hdmi"
+	DisplayPort  = "displayport"
+	AirPlay      = "airplay"
+	Virtual      = "virtual"
+	Aggregate    = "aggregate"
+	Unknown      = "unknown"
+
+	FullSecurity   = "full_security"
+	MediumSecurity = "medium_security"
+	NoSecurity     = "no_security"
+)
+
+// spKeys are the system_profiler key prefixes, without their leading "sp",
+// that Apple also puts in front of values, e.g. "spairport_" in
+// "spairport_status_connected".
+var spKeys = []string{
+	"airport", "audio", "battery", "bluetooth", "camera", "cardreader", "commandlinetools", "devtools", "diags",
+	"disc", "displays", "ethernet", "ext", "fibrechannel", "firewall", "firewire", "hardware", "ibridge",
+	"instruments", "managedclient", "memory", "network", "networklocation", "networkvolume", "nvme",
+	"parallelscsi", "pata", "pci", "power", "printers", "raw", "sas", "sata", "secureelement", "smartcards",
+	"software", "spi", "storage", "thunderbolt", "ua", "usb", "xcode",
+}
+
+// tokenPrefix matches the internal prefixes Apple puts in front of values,
+// e.g. "attrib_", "package_source_", "_spdisplays_", "spairport_",
+// "spfirewall_globalstate_" or "coreaudio_device_type_".
+var tokenPrefix = regexp.MustCompile(`^(attrib_|package_source_|_?sp(` + strings.Join(spKeys, "|") + `)_(globalstate_|status_|state_)?|coreaudio_device_type_|coreaudio_)`)
+
+// negations are the words that invert the value following them, as in
+// "spx_not_enabled"
+var negations = map[string]bool{"not": true, "no": true, "non": true}
+
+var (
+	tokensMu sync.RWMutex
+
+	// tokens maps lowercase internal tokens and localized strings to canonical values.
+	tokens = map[string]string{
+		// Booleans
+		"yes": Yes, "oui": Yes, "ja": Yes, "sí": Yes, "si": Yes, "sim": Yes, "да": Yes, "はい": Yes, "是": Yes, "예": Yes,
+		"no": No, "non": No, "nein": No, "não": No, "nao": No, "нет": No, "いいえ": No, "否": No, "아니요": No, "nee": No,
+		"true": Yes, "false": No, "1": Yes, "0": No,
+		"on": On, "activé": On, "activado": On, "ein": On, "attivo": On, "オン": On,
+		"off": Off, "désactivé": Off, "desactivado": Off, "aus": Off, "disattivo": Off, "オフ": Off,
+		"enabled": Enabled, "aktiviert": Enabled, "habilitado": Enabled, "abilitato": Enabled, "有効": Enabled, "已启用": Enabled,
+		"disabled": Disabled, "deaktiviert": Disabled, "deshabilitado": Disabled, "disabilitato": Disabled, "無効": Disabled, "已停用": Disabled,
+		"supported": Supported, "pris en charge": Supported, "unterstützt": Supported, "サポート": Supported,
+		"unsupported": Unsupported, "non pris en charge": Unsupported, "nicht unterstützt": Unsupported, "no compatible": Unsupported,
+
+		// SMART status
+		"verified": Verified, "vérifié": Verified, "verifiziert": Verified, "überprüft": Verified, "verificado": Verified,
+		"verificato": Verified, "検証済み": Verified, "已验证": Verified, "geverifieerd": Verified,
+		"failing": Failing, "défaillant": Failing, "fehlerhaft": Failing, "fallando": Failing, "fallito": Failing,
+		"not supported": NotSupported, "not_supported": NotSupported, "non supporté": NotSupported,
+
+		// Transports and connection types
+		"builtin": BuiltIn, "built-in": BuiltIn, "built_in": BuiltIn, "internal": BuiltIn, "intégré": BuiltIn, "integriert": BuiltIn,
+		"integrado": BuiltIn, "integrato": BuiltIn, "内蔵": BuiltIn, "内建": BuiltIn,
+		"usb": USB, "bluetooth": Bluetooth, "bluetoothle": Bluetooth, "thunderbolt": Thunderbolt,
+		"hdmi": HDMI, "displayport": DisplayPort, "airplay": AirPlay, "virtual": Virtual, "aggregate": Aggregate,
+		"unknown": Unknown, "inconnu": Unknown, "unbekannt": Unknown, "desconocido": Unknown,
+
+		// Disc burning support, e.g. "spdisc_burn_support_yes_apple_shipping"
+		"burn_support_yes_apple_shipping": Yes, "burn_support_yes_apple_upgrade": Yes,
+		"burn_support_yes_third_party": Yes, "burn_support_no": No,
+
+		// Secure Boot policies of the T2 chip
+		"full security": FullSecurity, "medium security": MediumSecurity, "no security": NoSecurity,
+		"allow booting from external media": Enabled, "allow booting from external or removable media": Enabled,
+		"disallow booting from external media": Disabled, "disallow booting from external or removable media": Disabled,
+	}
+)
+
+// Canonical maps a system_profiler value to a stable canonical value. Apple's
+// internal prefixes ("attrib_", "_spdisplays_", "spairport_", ...) are
+// stripped and known localized variants are translated, so "attrib_Yes",
+// "Oui" and "Yes" all become Yes. A token such as "secure_vm_disabled" maps
+// to the value of its last word, unless a negation precedes it as in
+// "spx_not_enabled". Unknown values are returned lowercased with their prefix
+// removed.
+func Canonical(value string) string {
+	token := strings.ToLower(strings.TrimSpace(value))
+	if c, ok := lookup(token); ok {
+		return c
+	}
+	stripped := tokenPrefix.ReplaceAllString(token, "")
+	if c, ok := lookup(stripped); ok {
+		return c
+	}
+	words := strings.Split(stripped, "_")
+	if len(words) > 1 && !negated(words[:len(words)-1]) {
+		if c, ok := lookup(words[len(words)-1]); ok {
+			return c
+		}
+	}
+	return stripped
+}
+
+// Token returns the canonical value of an internal token or a known
+// localized string, such as "coreaudio_device_type_usb" or "Oui", and whether
+// value is one. Free text such as device names, and numbers, are not tokens.
+func Token(value string) (string, bool) {
+	token := strings.ToLower(strings.TrimSpace(value))
+	if !strings.ContainsFunc(token, unicode.IsLetter) {
+		return "", false
+	}
+	if c, ok := lookup(token); ok {
+		return c, true
+	}
+	if tokenPrefix.MatchString(token) {
+		return Canonical(value), true
+	}
+	return "", false
+}
+
+// negated reports whether any of the words is a negation
+func negated(words []string) bool {
+	for _, word := range words {
+		if negations[word] {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterToken adds or replaces the canonical value for a token or localized
+// string. It is meant to be called during program initialization to cover
+// languages or tokens the built-in dictionary does not know.
+func RegisterToken(token, canonical string) {
+	tokensMu.Lock()
+	defer tokensMu.Unlock()
+	tokens[strings.ToLower(strings.TrimSpace(token))] = canonical
+}
+
+// lookup returns the canonical value for a lowercase token.
+func lookup(token string) (string, bool) {
+	tokensMu.RLock()
+	defer tokensMu.RUnlock()
+	c, ok := tokens[token]
+	return c, ok
+}
@@ -0,0 +1,193 @@
+package normalize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a dotted version number such as "14.6.1", as reported for
+// macOS, Xcode, firmware and drivers, with the suffixes Apple and vendors
+// append to it.
+type Version struct {
+	Major    int `json:"major"`
+	Minor    int `json:"minor"`
+	Patch    int `json:"patch"`
+	Revision int `json:"revision,omitempty"`
+	// PreRelease is a suffix such as "beta2" in "15.0-beta2" or "b3" in
+	// "17.0b3". A pre-release sorts before the release itself.
+	PreRelease string `json:"pre_release,omitempty"`
+	// RapidSecurityResponse is the letter of a Rapid Security Response, e.g.
+	// "a" in "macOS 13.4.1 (a) (22F770820d)". It sorts after the release.
+	RapidSecurityResponse string `json:"rapid_security_response,omitempty"`
+	// Build is a build number such as "23G93". It is not compared.
+	Build string `json:"build,omitempty"`
+}
+
+// ParseVersion parses the first version number in a value, e.g. "14.6.1",
+// "15.0-beta2", "15.4 (15F31d)", "macOS 13.4.1 (a) (22F770820d)" or
+// "SE OS 2.5". Words before the number are skipped; a "v" prefix is
+// allowed. Up to four components are read and missing ones are zero.
+func ParseVersion(value string) (Version, error) {
+	_, v, err := ParseNamedVersion(value)
+	return v, err
+}
+
+// ParseNamedVersion is like ParseVersion and also returns the words before
+// the version number, e.g. "macOS" for "macOS 14.6.1 (23G93)".
+func ParseNamedVersion(value string) (string, Version, error) {
+	fields := strings.Fields(value)
+	numeric := -1
+	for i, field := range fields {
+		if isDigit(strings.TrimPrefix(strings.TrimPrefix(field, "v"), "V")) {
+			numeric = i
+			break
+		}
+	}
+	if numeric < 0 {
+		return "", Version{}, fmt.Errorf("no version number in %q", value)
+	}
+
+	var v Version
+	field := strings.TrimLeft(fields[numeric], "vV")
+	end := strings.IndexFunc(field, func(r rune) bool { return r != '.' && (r < '0' || r > '9') })
+	if end < 0 {
+		end = len(field)
+	}
+	number, suffix := strings.TrimRight(field[:end], "."), field[end:]
+	parts := strings.Split(number, ".")
+	if len(parts) > 4 {
+		return "", Version{}, fmt.Errorf("invalid version %q: too many components", value)
+	}
+	targets := []*int{&v.Major, &v.Minor, &v.Patch, &v.Revision}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return "", Version{}, fmt.Errorf("invalid version %q: %w", value, err)
+		}
+		*targets[i] = n
+	}
+	if build, found := strings.CutPrefix(suffix, "+"); found {
+		v.Build = build
+	} else if pre := strings.TrimLeft(suffix, "-_."); isAlphanumeric(pre) {
+		v.PreRelease = pre
+	}
+
+	for _, field := range fields[numeric+1:] {
+		if !strings.HasPrefix(field, "(") || !strings.HasSuffix(field, ")") {
+			continue
+		}
+		if inner := strings.Trim(field, "()"); isResponse(inner) {
+			v.RapidSecurityResponse = inner
+		} else if inner != "" {
+			v.Build = inner
+		}
+	}
+	return strings.Join(fields[:numeric], " "), v, nil
+}
+
+// isDigit reports whether s starts with a digit
+func isDigit(s string) bool {
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+// isAlphanumeric reports whether s is a non-empty run of ASCII letters and
+// digits, so that punctuation after a version is not taken for a pre-release
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isResponse reports whether a parenthesized suffix names a Rapid Security
+// Response, which are single lowercase letters unlike build numbers
+func isResponse(s string) bool {
+	return len(s) == 1 && s[0] >= 'a' && s[0] <= 'z'
+}
+
+// Compare returns -1, 0 or 1 depending on whether v is older than, equal to
+// or newer than other. Components are compared numerically, a pre-release
+// is older than its release and a Rapid Security Response is newer than the
+// release it patches. Builds are not compared.
+func (v Version) Compare(other Version) int {
+	for _, pair := range [][2]int{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}, {v.Revision, other.Revision}} {
+		if c := compareInt(pair[0], pair[1]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case v.PreRelease == "" && other.PreRelease != "":
+		return 1
+	case v.PreRelease != "" && other.PreRelease == "":
+		return -1
+	}
+	if c := comparePreRelease(v.PreRelease, other.PreRelease); c != 0 {
+		return c
+	}
+	return strings.Compare(v.RapidSecurityResponse, other.RapidSecurityResponse)
+}
+
+// AtLeast reports whether v is the same as or newer than minimum, which is
+// parsed with ParseVersion.
+func (v Version) AtLeast(minimum string) (bool, error) {
+	m, err := ParseVersion(minimum)
+	if err != nil {
+		return false, err
+	}
+	return v.Compare(m) >= 0, nil
+}
+
+// String formats the version, e.g. "14.6.1", "15.0-beta2" or
+// "13.4.1 (a) (22F770820d)". The patch and revision are left out when zero.
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d", v.Major, v.Minor)
+	if v.Patch != 0 || v.Revision != 0 {
+		s += "." + strconv.Itoa(v.Patch)
+	}
+	if v.Revision != 0 {
+		s += "." + strconv.Itoa(v.Revision)
+	}
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	if v.RapidSecurityResponse != "" {
+		s += " (" + v.RapidSecurityResponse + ")"
+	}
+	if v.Build != "" {
+		s += " (" + v.Build + ")"
+	}
+	return s
+}
+
+// comparePreRelease compares pre-release suffixes such as "beta2" and
+// "beta10" by their letters and then by their number
+func comparePreRelease(a, b string) int {
+	al, an := splitPreRelease(a)
+	bl, bn := splitPreRelease(b)
+	if c := strings.Compare(al, bl); c != 0 {
+		return c
+	}
+	return compareInt(an, bn)
+}
+
+// splitPreRelease splits "beta2" into "beta" and 2
+func splitPreRelease(s string) (string, int) {
+	s = strings.ToLower(s)
+	i := strings.LastIndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }) + 1
+	n, _ := strconv.Atoi(s[i:])
+	return strings.TrimRight(s[:i], "-_."), n
+}
+
+// compareInt returns -1, 0 or 1
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,273 @@
+package policy
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/samburba/go-system-profiler/v2/normalize"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// maxEvidence is the number of values listed as evidence for one rule
+const maxEvidence = 10
+
+// Result is the outcome of one rule.
+type Result struct {
+	ID          string      `json:"id"`
+	Description string      `json:"description,omitempty"`
+	Severity    string      `json:"severity,omitempty"`
+	Path        string      `json:"path"`
+	Op          Op          `json:"op"`
+	Expected    interface{} `json:"expected,omitempty"`
+	Passed      bool        `json:"passed"`
+	// Evidence lists the values the decision was based on, e.g.
+	// "software[Mac].system_integrity = integrity_enabled". For failed
+	// rules it lists the values that did not pass.
+	Evidence []string `json:"evidence,omitempty"`
+	// Error explains why the rule could not be evaluated, for example
+	// because the snapshot lacks its data type. Such rules fail.
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the outcome of evaluating a policy.
+type Report struct {
+	Policy  string   `json:"policy,omitempty"`
+	Results []Result `json:"results"`
+}
+
+// Passed reports whether every rule passed.
+func (r *Report) Passed() bool {
+	return len(r.Failed()) == 0
+}
+
+// Failed returns the rules that did not pass.
+func (r *Report) Failed() []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if !result.Passed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Evaluate checks every rule of the policy against the snapshot. Rules that
+// cannot be evaluated fail with an error; they do not stop the evaluation.
+func Evaluate(p *Policy, s *snapshot.Snapshot) *Report {
+	report := &Report{Policy: p.Name, Results: make([]Result, 0, len(p.Rules))}
+	for _, rule := range p.Rules {
+		result := Result{
+			ID:          rule.ID,
+			Description: rule.Description,
+			Severity:    rule.Severity,
+			Path:        rule.Path,
+			Op:          rule.Op,
+			Expected:    rule.Value,
+		}
+		if err := evaluate(rule, s, &result); err != nil {
+			result.Passed = false
+			result.Error = err.Error()
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
+
+// evaluate fills in the outcome of a rule
+func evaluate(rule Rule, s *snapshot.Snapshot, result *Result) error {
+	values, err := resolve(s, rule.Path)
+	if err != nil {
+		return err
+	}
+	if rule.Op == OpCount {
+		return evaluateCount(rule, values, result)
+	}
+	if len(values) == 0 {
+		result.Evidence = []string{rule.Path + ": no values"}
+		return nil
+	}
+
+	var passed, failed []string
+	for _, v := range values {
+		ok, err := rule.Condition.test(v)
+		if err != nil {
+			return err
+		}
+		if ok {
+			passed = append(passed, evidence(v))
+		} else {
+			failed = append(failed, evidence(v))
+		}
+	}
+	if rule.Match == MatchAny {
+		result.Passed = len(passed) > 0
+	} else {
+		result.Passed = len(failed) == 0
+	}
+	if result.Passed {
+		result.Evidence = limit(passed)
+	} else {
+		result.Evidence = limit(failed)
+	}
+	return nil
+}
+
+// evaluateCount counts the values at the rule path that match Where
+func evaluateCount(rule Rule, values []value, result *Result) error {
+	var matched []string
+	for _, v := range values {
+		if !v.Found {
+			continue
+		}
+		ok := true
+		if rule.Where != nil {
+			var err error
+			if ok, err = rule.Where.testRelative(v); err != nil {
+				return err
+			}
+		}
+		if ok {
+			matched = append(matched, v.Label)
+		}
+	}
+
+	n := len(matched)
+	passed := true
+	var want []string
+	if rule.Value != nil {
+		expected, _ := toInt(rule.Value)
+		passed = n == expected
+		want = append(want, strconv.Itoa(expected))
+	}
+	if rule.Min != nil {
+		passed = passed && n >= *rule.Min
+		want = append(want, ">= "+strconv.Itoa(*rule.Min))
+	}
+	if rule.Max != nil {
+		passed = passed && n <= *rule.Max
+		want = append(want, "<= "+strconv.Itoa(*rule.Max))
+	}
+	result.Passed = passed != rule.Not
+	result.Evidence = append([]string{fmt.Sprintf("count = %d (want %s)", n, strings.Join(want, ", "))}, limit(matched)...)
+	return nil
+}
+
+// testRelative resolves the condition path below v and reports whether any
+// of the values there pass
+func (c Condition) testRelative(v value) (bool, error) {
+	var segments []string
+	if c.Path != "" {
+		segments = strings.Split(c.Path, ".")
+	}
+	values, err := descend([]value{v}, segments)
+	if err != nil {
+		return false, fmt.Errorf("where path %q: %w", c.Path, err)
+	}
+	for _, v := range values {
+		ok, err := c.test(v)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// test compares one value. A missing value compares as an empty string.
+func (c Condition) test(v value) (bool, error) {
+	var ok bool
+	switch c.Op {
+	case OpEq:
+		ok = equal(format(v.Value), format(c.Value))
+	case OpContains:
+		ok = contains(v.Value, format(c.Value))
+	case OpRegex:
+		re, err := regexp.Compile(format(c.Value))
+		if err != nil {
+			return false, err
+		}
+		ok = re.MatchString(format(v.Value))
+	case OpSemverGTE:
+		have, err := normalize.ParseVersion(format(v.Value))
+		if err != nil {
+			return false, nil
+		}
+		want, _ := normalize.ParseVersion(format(c.Value))
+		ok = have.Compare(want) >= 0
+	default:
+		return false, fmt.Errorf("op %q cannot compare a single value", c.Op)
+	}
+	return ok != c.Not, nil
+}
+
+// equal compares two values ignoring case, and also as canonical tokens so
+// that "spfirewall_globalstate_limit_connections" equals "limit_connections"
+// and a localized "Activé" equals "on"
+func equal(a, b string) bool {
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	ca, cb := normalize.Canonical(a), normalize.Canonical(b)
+	return ca != "" && ca == cb
+}
+
+// contains reports whether a list has an element equal to want, or whether
+// a string contains it
+func contains(v interface{}, want string) bool {
+	rv := indirect(reflect.ValueOf(v))
+	if rv.IsValid() && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) {
+		for i := 0; i < rv.Len(); i++ {
+			if equal(format(rv.Index(i).Interface()), want) {
+				return true
+			}
+		}
+		return false
+	}
+	return strings.Contains(strings.ToLower(format(v)), strings.ToLower(want))
+}
+
+// format returns the text of a value; nil is empty
+func format(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
+// evidence describes one value
+func evidence(v value) string {
+	if !v.Found {
+		return v.Label + " is missing"
+	}
+	return fmt.Sprintf("%s = %s", v.Label, format(v.Value))
+}
+
+// limit shortens a list of evidence to maxEvidence entries
+func limit(evidence []string) []string {
+	if len(evidence) <= maxEvidence {
+		return evidence
+	}
+	return append(evidence[:maxEvidence:maxEvidence], fmt.Sprintf("and %d more", len(evidence)-maxEvidence))
+}
+
+// toInt converts a count from YAML or JSON to an int
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case float64:
+		if n == float64(int(n)) {
+			return int(n), nil
+		}
+	case string:
+		if i, err := strconv.Atoi(n); err == nil {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("count needs a whole number, got %v", v)
+}
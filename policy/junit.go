@@ -0,0 +1,91 @@
+package policy
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// junitSuites is the root element of a JUnit XML report
+type junitSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Name     string       `xml:"name,attr,omitempty"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Errors   int          `xml:"errors,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+// junitSuite holds the rules of one policy
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+// junitCase is one rule
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// junitProblem is a failure or an error with details in its body
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report as JUnit XML with one testcase per rule, so
+// that CI systems show policy results next to test results. The class name
+// of a testcase is the data type of its rule; failures list the values that
+// did not pass, and rules that could not be evaluated are errors.
+func WriteJUnit(w io.Writer, r *Report) error {
+	name := r.Policy
+	if name == "" {
+		name = "policy"
+	}
+	suite := junitSuite{Name: name, Tests: len(r.Results)}
+
+	for _, result := range r.Results {
+		c := junitCase{
+			Name:      result.ID,
+			ClassName: name + "." + strings.SplitN(result.Path, ".", 2)[0],
+			Time:      "0",
+			SystemOut: result.Description,
+		}
+		switch {
+		case result.Error != "":
+			suite.Errors++
+			c.Error = &junitProblem{Message: result.Error, Body: strings.Join(result.Evidence, "\n")}
+		case !result.Passed:
+			suite.Failures++
+			c.Failure = &junitProblem{Message: message(result), Type: string(result.Op), Body: strings.Join(result.Evidence, "\n")}
+		}
+		suite.Cases = append(suite.Cases, c)
+	}
+
+	doc := junitSuites{
+		Name:     name,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Suites:   []junitSuite{suite},
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,219 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// errNoField reports a path segment the typed model does not have
+var errNoField = errors.New("no such field")
+
+// errorType is the reflect type of the error interface
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// value is a value found at a path, labelled with the item it belongs to
+type value struct {
+	Label string
+	Value interface{}
+	Found bool
+}
+
+// splitPath splits "software.system_integrity" into the data type and the
+// field segments
+func splitPath(path string) (snapshot.SPDataType, []string, error) {
+	segments := strings.Split(strings.TrimSpace(path), ".")
+	spType, ok := snapshot.Lookup(segments[0])
+	if !ok {
+		return "", nil, fmt.Errorf("unknown data type %q in path %q", segments[0], path)
+	}
+	for _, segment := range segments[1:] {
+		if segment == "" {
+			return "", nil, fmt.Errorf("empty segment in path %q", path)
+		}
+	}
+	return spType, segments[1:], nil
+}
+
+// resolve returns the values at a path. Segments are matched against the
+// typed model first: a JSON key, a Go field name or a method without
+// arguments, ignoring case. When the model has no such field the raw
+// system_profiler output is used instead.
+func resolve(s *snapshot.Snapshot, path string) ([]value, error) {
+	spType, segments, err := splitPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if !s.Has(spType) {
+		return nil, fmt.Errorf("snapshot does not contain %s", spType)
+	}
+
+	typed, err := s.Get(spType)
+	if err != nil {
+		return nil, err
+	}
+	values, err := walk(snapshot.ShortName(spType), reflect.ValueOf(typed), segments)
+	if !errors.Is(err, errNoField) {
+		return values, err
+	}
+
+	raw, err := snapshot.Items[map[string]interface{}](s, spType)
+	if err != nil {
+		return nil, err
+	}
+	values, err = walk(snapshot.ShortName(spType), reflect.ValueOf(raw), segments)
+	if errors.Is(err, errNoField) {
+		return nil, fmt.Errorf("path %q: %w", path, err)
+	}
+	return values, err
+}
+
+// walk resolves segments below the items of a data type
+func walk(prefix string, items reflect.Value, segments []string) ([]value, error) {
+	current := make([]value, 0, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i)
+		current = append(current, value{Label: fmt.Sprintf("%s[%s]", prefix, itemName(item, i)), Value: item.Interface(), Found: true})
+	}
+	return descend(current, segments)
+}
+
+// descend resolves segments below each value. Lists met before the last
+// segment are expanded, so every element is checked; a list at the end of
+// the path is kept as one value.
+func descend(current []value, segments []string) ([]value, error) {
+	for n, segment := range segments {
+		last := n == len(segments)-1
+		var next []value
+		for _, v := range current {
+			if !v.Found {
+				next = append(next, value{Label: v.Label + "." + segment})
+				continue
+			}
+			field, found, err := lookup(reflect.ValueOf(v.Value), segment)
+			if err != nil {
+				return nil, err
+			}
+			label := v.Label + "." + segment
+			if !found {
+				next = append(next, value{Label: label})
+				continue
+			}
+			if !last && (field.Kind() == reflect.Slice || field.Kind() == reflect.Array) {
+				for i := 0; i < field.Len(); i++ {
+					next = append(next, value{Label: fmt.Sprintf("%s[%d]", label, i), Value: field.Index(i).Interface(), Found: true})
+				}
+				continue
+			}
+			next = append(next, value{Label: label, Value: field.Interface(), Found: true})
+		}
+		current = next
+	}
+	return current, nil
+}
+
+// lookup returns the field, map entry or method result named segment
+func lookup(v reflect.Value, segment string) (reflect.Value, bool, error) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return reflect.Value{}, false, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, false, errNoField
+		}
+		for _, key := range v.MapKeys() {
+			if strings.EqualFold(key.String(), segment) {
+				entry := indirect(v.MapIndex(key))
+				return entry, entry.IsValid(), nil
+			}
+		}
+		return reflect.Value{}, false, nil
+	case reflect.Struct:
+		if field, ok := structField(v, segment); ok {
+			field = indirect(field)
+			return field, field.IsValid(), nil
+		}
+		if result, ok, err := callMethod(v, segment); ok {
+			return result, err == nil && result.IsValid(), nil
+		}
+	}
+	return reflect.Value{}, false, errNoField
+}
+
+// structField finds a field by JSON name or Go name, including fields of
+// embedded structs
+func structField(v reflect.Value, segment string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			name = ""
+		}
+		if strings.EqualFold(name, segment) || strings.EqualFold(f.Name, segment) {
+			return v.Field(i), true
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if field, ok := structField(v.Field(i), segment); ok {
+				return field, true
+			}
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// callMethod calls a method without arguments that returns a value, or a
+// value and an error
+func callMethod(v reflect.Value, segment string) (reflect.Value, bool, error) {
+	t := v.Type()
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if !strings.EqualFold(m.Name, segment) {
+			continue
+		}
+		mt := m.Type
+		switch {
+		case mt.NumIn() != 1:
+			continue
+		case mt.NumOut() == 1:
+			return v.Method(i).Call(nil)[0], true, nil
+		case mt.NumOut() == 2 && mt.Out(1) == errorType:
+			out := v.Method(i).Call(nil)
+			if err, _ := out[1].Interface().(error); err != nil {
+				return reflect.Value{}, true, err
+			}
+			return out[0], true, nil
+		}
+	}
+	return reflect.Value{}, false, nil
+}
+
+// indirect dereferences pointers and interfaces
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// itemName returns the "_name" of an item, or its index
+func itemName(item reflect.Value, index int) string {
+	if name, found, err := lookup(item, "_name"); err == nil && found && name.Kind() == reflect.String && name.String() != "" {
+		return name.String()
+	}
+	return fmt.Sprint(index)
+}
@@ -0,0 +1,266 @@
+// Package policy checks snapshots against compliance rules such as "System
+// Integrity Protection is enabled" or "macOS is at least 14.5".
+//
+// Rules are declared in YAML or JSON. Each rule names a field path in a
+// snapshot, an operator and the expected value:
+//
+//	name: Security baseline
+//	rules:
+//	  - id: sip-enabled
+//	    description: System Integrity Protection is enabled
+//	    path: software.system_integrity
+//	    op: eq
+//	    value: integrity_enabled
+//	  - id: minimum-os
+//	    path: software.os_version
+//	    op: semver_gte
+//	    value: "14.5"
+//	  - id: no-unsigned-kexts
+//	    path: extensions
+//	    op: count
+//	    where: {path: spext_signed_by, op: eq, value: ""}
+//	    value: 0
+//
+// A path starts with a data type, by full or short name, followed by field
+// names separated by ".". Fields are looked up in the models of the type
+// packages by JSON key or Go name, so "firewall.SpfirewallGlobalState" and
+// "firewall.spfirewall_globalstate" are the same field. Methods without
+// arguments can be used too, e.g. "software.SIPEnabled" or
+// "firewall.StealthEnabled". Fields the models do not declare are read from
+// the raw system_profiler output.
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/samburba/go-system-profiler/v2/normalize"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// Op is a comparison operator.
+type Op string
+
+// Supported operators.
+const (
+	// OpEq passes when the value equals the expected value, ignoring case.
+	OpEq Op = "eq"
+	// OpSemverGTE passes when the first version number in the value is at
+	// least the expected version, e.g. "macOS 14.6.1 (23G93)" >= "14.5".
+	// Pre-releases such as "15.0-beta2" are older than the release, Rapid
+	// Security Responses such as "13.4.1 (a)" are newer.
+	OpSemverGTE Op = "semver_gte"
+	// OpContains passes when a list value has an element equal to the
+	// expected value, or a string value contains it.
+	OpContains Op = "contains"
+	// OpRegex passes when the value matches the expected regular expression.
+	OpRegex Op = "regex"
+	// OpCount passes when the number of values, or of values matching Where,
+	// equals Value or lies between Min and Max.
+	OpCount Op = "count"
+)
+
+// opAliases maps alternative spellings to operators
+var opAliases = map[string]Op{
+	"=":         OpEq,
+	"==":        OpEq,
+	"equals":    OpEq,
+	">=":        OpSemverGTE,
+	"semver>=":  OpSemverGTE,
+	"version>=": OpSemverGTE,
+	"matches":   OpRegex,
+}
+
+// Match decides how a rule treats a path with several values.
+type Match string
+
+// Match modes.
+const (
+	// MatchAll requires every value to pass. It is the default.
+	MatchAll Match = "all"
+	// MatchAny requires at least one value to pass.
+	MatchAny Match = "any"
+)
+
+// Condition compares the values at a path.
+type Condition struct {
+	Path  string      `json:"path" yaml:"path"`
+	Op    Op          `json:"op" yaml:"op"`
+	Value interface{} `json:"value,omitempty" yaml:"value,omitempty"`
+	// Not inverts the result of the comparison.
+	Not bool `json:"not,omitempty" yaml:"not,omitempty"`
+}
+
+// Rule is a named check.
+type Rule struct {
+	ID          string `json:"id" yaml:"id"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Severity    string `json:"severity,omitempty" yaml:"severity,omitempty"`
+	Condition   `yaml:",inline"`
+	Match       Match `json:"match,omitempty" yaml:"match,omitempty"`
+	// Where filters the values counted by OpCount. Its path is relative to
+	// the values of the rule path.
+	Where *Condition `json:"where,omitempty" yaml:"where,omitempty"`
+	// Min and Max bound the count of OpCount.
+	Min *int `json:"min,omitempty" yaml:"min,omitempty"`
+	Max *int `json:"max,omitempty" yaml:"max,omitempty"`
+}
+
+// Policy is a set of rules.
+type Policy struct {
+	Name  string `json:"name,omitempty" yaml:"name,omitempty"`
+	Rules []Rule `json:"rules" yaml:"rules"`
+}
+
+// Parse reads a policy from YAML or JSON and validates its rules.
+func Parse(data []byte) (*Policy, error) {
+	var p Policy
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Load reads a policy file.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	p, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// validate normalizes operators and checks that every rule is complete
+func (p *Policy) validate() error {
+	if len(p.Rules) == 0 {
+		return errors.New("policy has no rules")
+	}
+	seen := make(map[string]bool, len(p.Rules))
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		if r.ID == "" {
+			return fmt.Errorf("rule %d has no id", i+1)
+		}
+		if seen[r.ID] {
+			return fmt.Errorf("duplicate rule id %q", r.ID)
+		}
+		seen[r.ID] = true
+
+		if err := r.Condition.validate(); err != nil {
+			return fmt.Errorf("rule %s: %w", r.ID, err)
+		}
+		switch r.Match {
+		case "":
+			r.Match = MatchAll
+		case MatchAll, MatchAny:
+		default:
+			return fmt.Errorf("rule %s: unknown match %q", r.ID, r.Match)
+		}
+		if r.Op == OpCount {
+			if r.Value == nil && r.Min == nil && r.Max == nil {
+				return fmt.Errorf("rule %s: count needs a value, min or max", r.ID)
+			}
+			if r.Value != nil {
+				if _, err := toInt(r.Value); err != nil {
+					return fmt.Errorf("rule %s: %w", r.ID, err)
+				}
+			}
+		} else if r.Where != nil || r.Min != nil || r.Max != nil {
+			return fmt.Errorf("rule %s: where, min and max only apply to count", r.ID)
+		}
+		if r.Where != nil {
+			if err := r.Where.validateRelative(); err != nil {
+				return fmt.Errorf("rule %s: where: %w", r.ID, err)
+			}
+			if r.Where.Op == OpCount {
+				return fmt.Errorf("rule %s: where cannot count", r.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// validate checks a condition whose path starts with a data type
+func (c *Condition) validate() error {
+	if c.Path == "" {
+		return errors.New("no path")
+	}
+	if _, _, err := splitPath(c.Path); err != nil {
+		return err
+	}
+	return c.validateOp()
+}
+
+// validateRelative checks a condition whose path is relative to a value
+func (c *Condition) validateRelative() error {
+	for _, segment := range strings.Split(c.Path, ".") {
+		if segment == "" && c.Path != "" {
+			return fmt.Errorf("empty segment in path %q", c.Path)
+		}
+	}
+	return c.validateOp()
+}
+
+// validateOp normalizes the operator and checks the expected value
+func (c *Condition) validateOp() error {
+	if op, ok := opAliases[strings.ToLower(string(c.Op))]; ok {
+		c.Op = op
+	}
+	c.Op = Op(strings.ToLower(string(c.Op)))
+	switch c.Op {
+	case OpEq, OpContains:
+		if c.Value == nil {
+			return fmt.Errorf("%s needs a value", c.Op)
+		}
+	case OpSemverGTE:
+		if _, err := normalize.ParseVersion(format(c.Value)); err != nil {
+			return fmt.Errorf("%s needs a version, got %v", c.Op, c.Value)
+		}
+	case OpRegex:
+		pattern, ok := c.Value.(string)
+		if !ok {
+			return fmt.Errorf("regex needs a string value")
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+	case OpCount:
+	case "":
+		return errors.New("no op")
+	default:
+		return fmt.Errorf("unknown op %q", c.Op)
+	}
+	return nil
+}
+
+// Types returns the data types the rules read, sorted by name.
+func (p *Policy) Types() []snapshot.SPDataType {
+	seen := map[snapshot.SPDataType]bool{}
+	var types []snapshot.SPDataType
+	for _, r := range p.Rules {
+		spType, _, err := splitPath(r.Path)
+		if err != nil || seen[spType] {
+			continue
+		}
+		seen[spType] = true
+		types = append(types, spType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
@@ -0,0 +1,325 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+const data = `{
+	"SPSoftwareDataType": [{
+		"_name": "os_overview",
+		"os_version": "macOS 14.6.1 (23G93)",
+		"system_integrity": "integrity_enabled"
+	}],
+	"SPFirewallDataType": [{
+		"_name": "firewall_settings",
+		"spfirewall_globalstate": "spfirewall_globalstate_limit_connections",
+		"spfirewall_stealthenabled": "No"
+	}],
+	"SPHardwareDataType": [{"_name": "hardware_overview", "activation_lock_status": "activation_lock_enabled"}],
+	"SPExtensionsDataType": [{
+		"_name": "Extensions",
+		"_items": [
+			{"_name": "AppleAPFS", "spext_signed_by": "Software Signing, Apple Code Signing Certification Authority, Apple Root CA"},
+			{"_name": "SoftRAID", "spext_path": "/Library/Extensions/SoftRAID.kext"}
+		]
+	}],
+	"SPUSBDataType": [{"_name": "USB31Bus", "_items": [{"_name": "YubiKey", "serial_num": "Y1"}]}]
+}`
+
+const baseline = `
+name: Security baseline
+rules:
+  - id: sip-enabled
+    description: System Integrity Protection is enabled
+    path: software.system_integrity
+    op: eq
+    value: integrity_enabled
+  - id: sip-method
+    path: software.SIPEnabled
+    op: eq
+    value: true
+  - id: firewall-on
+    path: firewall.SpfirewallGlobalState
+    op: eq
+    value: limit_connections
+  - id: stealth-mode
+    path: firewall.StealthEnabled
+    op: eq
+    value: true
+  - id: activation-lock
+    path: SPHardwareDataType.activation_lock_status
+    op: regex
+    value: "enabled$"
+  - id: minimum-os
+    path: software.os_version
+    op: ">="
+    value: "14.5"
+  - id: macos-15
+    path: software.os_version
+    op: semver_gte
+    value: "15"
+  - id: no-unsigned-kexts
+    path: extensions
+    op: count
+    where: {path: spext_signed_by, op: eq, value: ""}
+    value: 0
+  - id: apple-root
+    path: extensions.Signers
+    op: contains
+    value: Apple Root CA
+    match: any
+  - id: yubikey
+    path: usb.serial_num
+    op: eq
+    value: Y1
+    match: any
+  - id: bluetooth
+    path: bluetooth.controller_state
+    op: eq
+    value: on
+`
+
+// evaluateBaseline evaluates the baseline policy against the test data
+func evaluateBaseline(t *testing.T) *Report {
+	t.Helper()
+	p, err := Parse([]byte(baseline))
+	if err != nil {
+		t.Fatalf("Failed to parse policy: %v", err)
+	}
+	s, err := snapshot.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to parse snapshot: %v", err)
+	}
+	return Evaluate(p, s)
+}
+
+func TestEvaluate(t *testing.T) {
+	report := evaluateBaseline(t)
+	want := map[string]bool{
+		"sip-enabled":       true,
+		"sip-method":        true,
+		"firewall-on":       true,
+		"stealth-mode":      false,
+		"activation-lock":   true,
+		"minimum-os":        true,
+		"macos-15":          false,
+		"no-unsigned-kexts": false,
+		"apple-root":        true,
+		"yubikey":           true,
+		"bluetooth":         false,
+	}
+	if len(report.Results) != len(want) {
+		t.Fatalf("Expected %d results, got %d", len(want), len(report.Results))
+	}
+	for _, result := range report.Results {
+		if result.Passed != want[result.ID] {
+			t.Errorf("Rule %s: expected passed=%v, got %v (evidence %v, error %q)", result.ID, want[result.ID], result.Passed, result.Evidence, result.Error)
+		}
+	}
+	if report.Passed() || len(report.Failed()) != 4 {
+		t.Errorf("Expected 4 failed rules, got %d", len(report.Failed()))
+	}
+
+	results := map[string]Result{}
+	for _, result := range report.Results {
+		results[result.ID] = result
+	}
+	if got := results["no-unsigned-kexts"].Evidence; len(got) != 2 || got[0] != "count = 1 (want 0)" || got[1] != "extensions[SoftRAID]" {
+		t.Errorf("Unexpected count evidence: %v", got)
+	}
+	if got := results["sip-enabled"].Evidence; len(got) != 1 || got[0] != "software[os_overview].system_integrity = integrity_enabled" {
+		t.Errorf("Unexpected evidence: %v", got)
+	}
+	if results["bluetooth"].Error == "" {
+		t.Error("Expected an error for a data type missing from the snapshot")
+	}
+
+	var buf bytes.Buffer
+	if err := WriteText(&buf, report); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	for _, line := range []string{"PASS  sip-enabled: System Integrity Protection is enabled", "FAIL  stealth-mode", "ERROR bluetooth", "7 of 11 rules passed"} {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("Expected %q in:\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteSARIF(&buf, evaluateBaseline(t), "policies/baseline.yaml"); err != nil {
+		t.Fatalf("Failed to write SARIF: %v", err)
+	}
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string                `json:"ruleId"`
+				Kind      string                `json:"kind"`
+				Level     string                `json:"level"`
+				Message   struct{ Text string } `json:"message"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct{ URI string } `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("Failed to parse SARIF: %v", err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("Unexpected SARIF log: %s", buf.String())
+	}
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 11 || len(run.Results) != 11 {
+		t.Fatalf("Expected 11 rules and results, got %d and %d", len(run.Tool.Driver.Rules), len(run.Results))
+	}
+	for _, result := range run.Results {
+		switch result.RuleID {
+		case "sip-enabled":
+			if result.Kind != "pass" || result.Level != "none" {
+				t.Errorf("Expected a passed result, got %s/%s", result.Kind, result.Level)
+			}
+		case "no-unsigned-kexts":
+			if result.Kind != "fail" || result.Level != "error" || !strings.Contains(result.Message.Text, "extensions[SoftRAID]") {
+				t.Errorf("Unexpected failed result: %+v", result)
+			}
+			if uri := result.Locations[0].PhysicalLocation.ArtifactLocation.URI; uri != "policies/baseline.yaml" {
+				t.Errorf("Unexpected location %q", uri)
+			}
+		}
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJUnit(&buf, evaluateBaseline(t)); err != nil {
+		t.Fatalf("Failed to write JUnit XML: %v", err)
+	}
+
+	var suites struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Errors   int `xml:"errors,attr"`
+		Suites   []struct {
+			Name  string `xml:"name,attr"`
+			Cases []struct {
+				Name      string `xml:"name,attr"`
+				ClassName string `xml:"classname,attr"`
+				Failure   *struct {
+					Message string `xml:"message,attr"`
+					Body    string `xml:",chardata"`
+				} `xml:"failure"`
+				Error *struct{} `xml:"error"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &suites); err != nil {
+		t.Fatalf("Failed to parse JUnit XML: %v", err)
+	}
+	if suites.Tests != 11 || suites.Failures != 3 || suites.Errors != 1 {
+		t.Errorf("Expected 11 tests, 3 failures and 1 error, got %d, %d and %d", suites.Tests, suites.Failures, suites.Errors)
+	}
+	if len(suites.Suites) != 1 || suites.Suites[0].Name != "Security baseline" || len(suites.Suites[0].Cases) != 11 {
+		t.Fatalf("Unexpected suites: %s", buf.String())
+	}
+	for _, c := range suites.Suites[0].Cases {
+		switch c.Name {
+		case "stealth-mode":
+			if c.ClassName != "Security baseline.firewall" || c.Failure == nil || !strings.Contains(c.Failure.Body, "StealthEnabled = false") {
+				t.Errorf("Unexpected testcase: %+v", c)
+			}
+		case "bluetooth":
+			if c.Error == nil {
+				t.Error("Expected an error for the bluetooth rule")
+			}
+		}
+	}
+}
+
+func TestSemverSuffixes(t *testing.T) {
+	p, err := Parse([]byte(`
+rules:
+  - {id: release, path: software.os_version, op: semver_gte, value: "13.4.1"}
+  - {id: response, path: software.os_version, op: semver_gte, value: "13.4.1 (a)"}
+  - {id: next-response, path: software.os_version, op: semver_gte, value: "13.4.1 (b)"}
+  - {id: beta, path: software.os_version, op: semver_gte, value: "13.5-beta1"}
+  - {id: beta-build, path: software.kernel_version, op: semver_gte, value: "23.0"}
+`))
+	if err != nil {
+		t.Fatalf("Failed to parse policy: %v", err)
+	}
+	s, err := snapshot.Parse([]byte(`{"SPSoftwareDataType": [{"_name": "os_overview", "os_version": "macOS 13.4.1 (a) (22F770820d)", "kernel_version": "Darwin 23.0-beta3"}]}`))
+	if err != nil {
+		t.Fatalf("Failed to parse snapshot: %v", err)
+	}
+	want := map[string]bool{"release": true, "response": true, "next-response": false, "beta": false, "beta-build": false}
+	for _, result := range Evaluate(p, s).Results {
+		if result.Passed != want[result.ID] {
+			t.Errorf("Rule %s: expected passed=%v, got %v (%v)", result.ID, want[result.ID], result.Passed, result.Evidence)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"no rules":      `name: empty`,
+		"unknown type":  `rules: [{id: a, path: nosuch.field, op: eq, value: x}]`,
+		"unknown op":    `rules: [{id: a, path: software.os_version, op: lt, value: x}]`,
+		"bad regex":     `rules: [{id: a, path: software.os_version, op: regex, value: "("}]`,
+		"bad version":   `rules: [{id: a, path: software.os_version, op: semver_gte, value: latest}]`,
+		"duplicate id":  `rules: [{id: a, path: software.os_version, op: eq, value: x}, {id: a, path: software.uptime, op: eq, value: x}]`,
+		"unknown key":   `rules: [{id: a, path: software.os_version, op: eq, value: x, severity: high, colour: red}]`,
+		"where on eq":   `rules: [{id: a, path: software, op: eq, value: x, where: {path: os_version, op: eq, value: x}}]`,
+		"count no want": `rules: [{id: a, path: extensions, op: count}]`,
+	}
+	for name, policy := range tests {
+		if _, err := Parse([]byte(policy)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	p, err := Parse([]byte(`{"rules": [{"id": "a", "path": "SPFirewallDataType.spfirewall_globalstate", "op": "==", "value": "on"}]}`))
+	if err != nil {
+		t.Fatalf("Failed to parse JSON policy: %v", err)
+	}
+	if p.Rules[0].Op != OpEq || p.Rules[0].Match != MatchAll {
+		t.Errorf("Expected normalized op and match, got %q and %q", p.Rules[0].Op, p.Rules[0].Match)
+	}
+	if types := p.Types(); len(types) != 1 || types[0] != common.SPFirewallDataType {
+		t.Errorf("Unexpected types: %v", types)
+	}
+}
+
+func TestExamplePolicy(t *testing.T) {
+	p, err := Load("../examples/policy.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load example policy: %v", err)
+	}
+	s, err := snapshot.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to parse snapshot: %v", err)
+	}
+	for _, result := range Evaluate(p, s).Results {
+		if result.Error != "" {
+			t.Errorf("Rule %s: %s", result.ID, result.Error)
+		}
+	}
+}
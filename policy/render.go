@@ -0,0 +1,72 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteJSON writes the report as indented JSON.
+func WriteJSON(w io.Writer, r *Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteText writes the report for humans, one line per rule followed by its
+// evidence:
+//
+//	PASS sip-enabled: System Integrity Protection is enabled
+//	       software[Mac].system_integrity = integrity_enabled
+//	FAIL stealth-mode: Stealth mode is on
+//	       firewall[0].spfirewall_stealthenabled = No
+//
+//	1 of 2 rules passed
+func WriteText(w io.Writer, r *Report) error {
+	if r.Policy != "" {
+		fmt.Fprintf(w, "%s\n\n", r.Policy)
+	}
+	passed := 0
+	for _, result := range r.Results {
+		status := "FAIL"
+		if result.Passed {
+			status = "PASS"
+			passed++
+		}
+		if result.Error != "" {
+			status = "ERROR"
+		}
+		line := fmt.Sprintf("%-5s %s", status, result.ID)
+		if result.Description != "" {
+			line += ": " + result.Description
+		}
+		fmt.Fprintln(w, line)
+		if result.Error != "" {
+			fmt.Fprintf(w, "       %s\n", result.Error)
+		}
+		for _, e := range result.Evidence {
+			fmt.Fprintf(w, "       %s\n", e)
+		}
+	}
+	_, err := fmt.Fprintf(w, "\n%d of %d rules passed\n", passed, len(r.Results))
+	return err
+}
+
+// message summarizes a result in one line, e.g. "Stealth mode is on:
+// failed: firewall[0].StealthEnabled = false"
+func message(result Result) string {
+	name := result.ID
+	if result.Description != "" {
+		name = result.Description
+	}
+	switch {
+	case result.Error != "":
+		return name + ": " + result.Error
+	case result.Passed:
+		return name + ": passed"
+	case len(result.Evidence) == 0:
+		return name + ": failed"
+	}
+	return name + ": failed: " + strings.Join(result.Evidence, "; ")
+}
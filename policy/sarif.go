@@ -0,0 +1,158 @@
+package policy
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+// toolName identifies this library in generated documents
+const toolName = "go-system-profiler"
+
+// sarifLog is a SARIF 2.1.0 log with a single run
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+// sarifRun is one evaluation of a policy
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+// sarifTool describes the tool and the rules it checks
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+// sarifDriver is the tool component that ran the rules
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+// sarifRule describes one policy rule
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     *sarifMessage      `json:"shortDescription,omitempty"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	Properties           map[string]string  `json:"properties,omitempty"`
+}
+
+// sarifConfiguration holds the level reported for a failed rule
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+// sarifMessage is a plain text message
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+// sarifResult is the outcome of one rule
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Kind       string                 `json:"kind"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// sarifLocation points at the policy file and at the field that was checked
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+// sarifPhysicalLocation is a file
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+// sarifArtifactLocation is the URI of a file
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// sarifLogicalLocation is a field path in the snapshot
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// WriteSARIF writes the report as a SARIF 2.1.0 log for code scanning tools.
+// Every rule becomes a SARIF rule; failed rules are reported at the level of
+// their severity and passed rules with kind "pass". When policyFile is not
+// empty, results point at it so that tools which require a file location
+// accept them.
+func WriteSARIF(w io.Writer, r *Report, policyFile string) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           toolName,
+			InformationURI: "https://github.com/samburba/go-system-profiler",
+			Rules:          make([]sarifRule, 0, len(r.Results)),
+		}},
+		Results: make([]sarifResult, 0, len(r.Results)),
+	}
+
+	for i, result := range r.Results {
+		rule := sarifRule{
+			ID:                   result.ID,
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(result.Severity)},
+			Properties:           map[string]string{"path": result.Path, "op": string(result.Op)},
+		}
+		if result.Description != "" {
+			rule.ShortDescription = &sarifMessage{Text: result.Description}
+		}
+		if result.Severity != "" {
+			rule.Properties["severity"] = result.Severity
+		}
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+
+		out := sarifResult{
+			RuleID:    result.ID,
+			RuleIndex: i,
+			Kind:      "fail",
+			Level:     rule.DefaultConfiguration.Level,
+			Message:   sarifMessage{Text: message(result)},
+		}
+		if result.Passed {
+			out.Kind, out.Level = "pass", "none"
+		}
+		location := sarifLocation{LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: result.Path, Kind: "member"}}}
+		if policyFile != "" {
+			location.PhysicalLocation = &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: policyFile}}
+		}
+		out.Locations = []sarifLocation{location}
+		if len(result.Evidence) > 0 {
+			out.Properties = map[string]interface{}{"evidence": result.Evidence}
+		}
+		run.Results = append(run.Results, out)
+	}
+
+	doc := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// sarifLevel maps a rule severity to a SARIF level; rules without a known
+// severity are errors
+func sarifLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "medium", "moderate", "warning":
+		return "warning"
+	case "low", "info", "note":
+		return "note"
+	}
+	return "error"
+}
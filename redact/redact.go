@@ -0,0 +1,231 @@
+// Package redact removes or pseudonymizes personal and identifying data,
+// such as serial numbers, hardware UUIDs, MAC and Bluetooth addresses, the
+// user name, the host name and Wi-Fi SSIDs, before snapshots leave the Mac.
+//
+// Identifying fields are listed in a registry of system_profiler keys, so
+// the same fields are redacted in every data type, in raw snapshots and in
+// the typed items of the type packages alike:
+//
+//	r, err := redact.New(redact.Options{Mode: redact.Hash, Key: key})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	clean, err := r.Snapshot(s)
+//
+// Hash replaces a value with a keyed HMAC-SHA256, so the same serial number
+// always maps to the same pseudonym and records can still be joined without
+// revealing it.
+package redact
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// Mode is the way identifying values are redacted.
+type Mode string
+
+// Redaction modes.
+const (
+	// Drop removes identifying fields.
+	Drop Mode = "drop"
+	// Mask replaces letters and digits with "*". Serial numbers, identifiers
+	// and addresses keep their last four, e.g. "C02XK0ABJG5J" becomes
+	// "********JG5J"; user, host and network names are masked entirely.
+	Mask Mode = "mask"
+	// Hash replaces values with the hex encoded first 16 bytes of their
+	// HMAC-SHA256 under Options.Key.
+	Hash Mode = "hash"
+)
+
+// ParseMode returns the mode named by value.
+func ParseMode(value string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case Drop, Mask, Hash:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown redaction mode %q (use drop, mask or hash)", value)
+}
+
+// Options controls a Redactor.
+type Options struct {
+	Mode Mode
+	// Key is the HMAC key used by Hash. Keep it secret: anyone who has it
+	// can test guesses of the original values.
+	Key []byte
+	// Categories limits redaction to some categories. All categories are
+	// redacted when it is empty.
+	Categories []Category
+}
+
+// Redactor redacts identifying fields.
+type Redactor struct {
+	opts       Options
+	categories map[Category]bool
+}
+
+// New returns a Redactor for the given options.
+func New(opts Options) (*Redactor, error) {
+	if _, err := ParseMode(string(opts.Mode)); err != nil {
+		return nil, err
+	}
+	if opts.Mode == Hash && len(opts.Key) == 0 {
+		return nil, errors.New("hash redaction needs a key")
+	}
+	r := &Redactor{opts: opts}
+	if len(opts.Categories) > 0 {
+		r.categories = make(map[Category]bool, len(opts.Categories))
+		for _, c := range opts.Categories {
+			r.categories[c] = true
+		}
+	}
+	return r, nil
+}
+
+// Snapshot returns a copy of the snapshot with identifying fields redacted.
+// The original snapshot is not modified.
+func (r *Redactor) Snapshot(s *snapshot.Snapshot) (*snapshot.Snapshot, error) {
+	out := &snapshot.Snapshot{CollectedAt: s.CollectedAt, Data: make(map[snapshot.SPDataType]json.RawMessage, len(s.Data))}
+	for spType, raw := range s.Data {
+		data, err := r.JSON(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to redact %s: %w", spType, err)
+		}
+		out.Data[spType] = data
+	}
+	return out, nil
+}
+
+// JSON redacts a JSON document such as the output of "system_profiler
+// -json".
+func (r *Redactor) JSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return json.Marshal(r.redact(v, ""))
+}
+
+// Value redacts a typed value in place, for example a *hardware.DataTypeItem
+// or a *[]software.DataTypeItem. Fields are matched by their JSON names;
+// dropped fields are set to their zero value.
+func (r *Redactor) Value(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return errors.New("redact: Value needs a non-nil pointer")
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if data, err = r.JSON(data); err != nil {
+		return err
+	}
+	rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+	return json.Unmarshal(data, v)
+}
+
+// redact walks a decoded JSON value; parent is the key the value was found
+// under
+func (r *Redactor) redact(v interface{}, parent string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			category, ok := lookup(key, parent)
+			if !ok || !r.enabled(category) {
+				v[key] = r.redact(value, key)
+				continue
+			}
+			if r.opts.Mode == Drop {
+				delete(v, key)
+				continue
+			}
+			v[key] = r.replace(value, category)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = r.redact(value, parent)
+		}
+		return v
+	}
+	return v
+}
+
+// enabled reports whether a category is redacted
+func (r *Redactor) enabled(category Category) bool {
+	return r.categories == nil || r.categories[category]
+}
+
+// replace masks or hashes a value; lists are redacted element by element
+func (r *Redactor) replace(v interface{}, category Category) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		for i, value := range v {
+			v[i] = r.replace(value, category)
+		}
+		return v
+	case map[string]interface{}, nil:
+		return v
+	}
+
+	value := fmt.Sprint(v)
+	if value == "" {
+		return value
+	}
+	if r.opts.Mode == Mask {
+		return mask(value, category)
+	}
+	mac := hmac.New(sha256.New, r.opts.Key)
+	mac.Write([]byte(category))
+	mac.Write([]byte{0})
+	mac.Write([]byte(canonical(value, category)))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// mask replaces letters and digits with "*". Hardware identifiers keep their
+// last four when they are long enough, names never keep any
+func mask(value string, category Category) string {
+	runes := []rune(value)
+	keep := 0
+	switch category {
+	case Serial, Identifier, MACAddress, BluetoothAddress:
+		keep = 4
+	}
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i]) {
+			continue
+		}
+		if keep > 0 && len(runes) > 8 {
+			keep--
+			continue
+		}
+		runes[i] = '*'
+	}
+	return string(runes)
+}
+
+// canonical normalizes a value before hashing, so that "AA-BB-CC-DD-EE-FF"
+// and "aa:bb:cc:dd:ee:ff" get the same pseudonym
+func canonical(value string, category Category) string {
+	value = strings.TrimSpace(value)
+	switch category {
+	case MACAddress, BluetoothAddress:
+		return strings.ReplaceAll(strings.ToLower(value), "-", ":")
+	case Identifier:
+		return strings.ToLower(value)
+	}
+	return value
+}
@@ -0,0 +1,167 @@
+package redact
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+	"github.com/samburba/go-system-profiler/v2/type/hardware"
+	"github.com/samburba/go-system-profiler/v2/type/software"
+)
+
+const data = `{
+	"SPHardwareDataType": [{
+		"_name": "hardware_overview",
+		"machine_model": "Mac15,3",
+		"serial_number": "C02XK0ABJG5J",
+		"platform_UUID": "0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0",
+		"provisioning_UDID": "00008112-000A1B2C3D4E5F6A"
+	}],
+	"SPSoftwareDataType": [{"_name": "os_overview", "user_name": "Sam Example (sam)", "local_host_name": "Sams-MacBook-Pro", "os_version": "macOS 14.5 (23F79)"}],
+	"SPNetworkDataType": [{"_name": "Wi-Fi", "Ethernet": {"MAC Address": "AA:BB:CC:DD:EE:FF"}}],
+	"SPBluetoothDataType": [{
+		"controller_properties": {"controller_address": "aa-bb-cc-dd-ee-ff", "controller_state": "attrib_on"},
+		"device_not_connected": [{"Sam's AirPods": {"device_address": "11:22:33:44:55:66"}}]
+	}],
+	"SPAirPortDataType": [{
+		"spairport_airport_interfaces": [{
+			"_name": "en0",
+			"spairport_current_network_information": {"_name": "HomeNetwork", "spairport_network_channel": "36"},
+			"spairport_airport_other_local_wireless_networks": [{"_name": "CoffeeShop"}]
+		}]
+	}]
+}`
+
+// secrets lists every identifying value in data
+var secrets = []string{
+	"C02XK0ABJG5J", "0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0", "00008112-000A1B2C3D4E5F6A", "Sam Example", "(sam)",
+	"Sams-MacBook-Pro", "AA:BB:CC:DD:EE:FF", "aa-bb-cc-dd-ee-ff", "11:22:33:44:55:66", "HomeNetwork", "CoffeeShop",
+}
+
+func mustParse(t *testing.T) *snapshot.Snapshot {
+	t.Helper()
+	s, err := snapshot.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to parse snapshot: %v", err)
+	}
+	return s
+}
+
+// dump returns all data of a snapshot as one string
+func dump(s *snapshot.Snapshot) string {
+	var b strings.Builder
+	for _, spType := range s.Types() {
+		b.Write(s.Data[spType])
+	}
+	return b.String()
+}
+
+func TestSnapshotModes(t *testing.T) {
+	original := mustParse(t)
+	for _, mode := range []Mode{Drop, Mask, Hash} {
+		r, err := New(Options{Mode: mode, Key: []byte("secret")})
+		if err != nil {
+			t.Fatalf("Failed to create %s redactor: %v", mode, err)
+		}
+		s, err := r.Snapshot(original)
+		if err != nil {
+			t.Fatalf("Failed to redact with %s: %v", mode, err)
+		}
+		out := dump(s)
+		for _, secret := range secrets {
+			if strings.Contains(out, secret) {
+				t.Errorf("%s: %q was not redacted", mode, secret)
+			}
+		}
+		for _, kept := range []string{"Mac15,3", "macOS 14.5 (23F79)", "Wi-Fi", "en0", "attrib_on"} {
+			if !strings.Contains(out, kept) {
+				t.Errorf("%s: %q should not be redacted", mode, kept)
+			}
+		}
+	}
+	if !strings.Contains(dump(original), "C02XK0ABJG5J") {
+		t.Error("Expected the original snapshot to be left unchanged")
+	}
+}
+
+func TestMaskAndHash(t *testing.T) {
+	s := mustParse(t)
+
+	masked, err := New(Options{Mode: Mask})
+	if err != nil {
+		t.Fatalf("Failed to create redactor: %v", err)
+	}
+	out, err := masked.Snapshot(s)
+	if err != nil {
+		t.Fatalf("Failed to redact: %v", err)
+	}
+	items, err := snapshot.Items[hardware.DataTypeItem](out, common.SPHardwareDataType)
+	if err != nil {
+		t.Fatalf("Failed to decode hardware: %v", err)
+	}
+	if items[0].SerialNumber != "********JG5J" {
+		t.Errorf("Unexpected masked serial %q", items[0].SerialNumber)
+	}
+
+	hashed, err := New(Options{Mode: Hash, Key: []byte("secret"), Categories: []Category{MACAddress, BluetoothAddress}})
+	if err != nil {
+		t.Fatalf("Failed to create redactor: %v", err)
+	}
+	out, err = hashed.Snapshot(s)
+	if err != nil {
+		t.Fatalf("Failed to redact: %v", err)
+	}
+	network := string(out.Data[common.SPNetworkDataType])
+	bluetooth := string(out.Data[common.SPBluetoothDataType])
+	pseudonym := hashed.replace("aa:bb:cc:dd:ee:ff", MACAddress).(string)
+	if len(pseudonym) != 32 || !strings.Contains(network, pseudonym) {
+		t.Errorf("Expected pseudonym %s in %s", pseudonym, network)
+	}
+	if strings.Contains(bluetooth, pseudonym) {
+		t.Error("Expected Bluetooth and network addresses to use different pseudonyms")
+	}
+	if !strings.Contains(string(out.Data[common.SPHardwareDataType]), "C02XK0ABJG5J") {
+		t.Error("Expected serial numbers to be kept when only addresses are redacted")
+	}
+
+	other, _ := New(Options{Mode: Hash, Key: []byte("other")})
+	if other.replace("aa:bb:cc:dd:ee:ff", MACAddress) == pseudonym {
+		t.Error("Expected different keys to give different pseudonyms")
+	}
+}
+
+func TestValue(t *testing.T) {
+	r, err := New(Options{Mode: Drop})
+	if err != nil {
+		t.Fatalf("Failed to create redactor: %v", err)
+	}
+	items := []software.DataTypeItem{{Name: "os_overview", UserName: "Sam Example (sam)", LocalHostName: "Sams-MacBook-Pro", OsVersion: "macOS 14.5 (23F79)"}}
+	if err := r.Value(&items); err != nil {
+		t.Fatalf("Failed to redact value: %v", err)
+	}
+	if items[0].UserName != "" || items[0].LocalHostName != "" || items[0].OsVersion != "macOS 14.5 (23F79)" {
+		t.Errorf("Unexpected redacted item: %+v", items[0])
+	}
+	if err := r.Value(items); err == nil {
+		t.Error("Expected an error for a non-pointer value")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	if _, err := New(Options{Mode: Hash}); err == nil {
+		t.Error("Expected an error for hash without a key")
+	}
+	if _, err := ParseMode("scramble"); err == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+	if mode, err := ParseMode(" MASK "); err != nil || mode != Mask {
+		t.Errorf("Expected mask, got %q (%v)", mode, err)
+	}
+	if got := mask("1234", Serial); got != "****" {
+		t.Errorf("Expected short values to be fully masked, got %q", got)
+	}
+	if got := mask("Sam Example (sam)", UserName); got != "*** ******* (***)" {
+		t.Errorf("Expected names to be fully masked, got %q", got)
+	}
+}
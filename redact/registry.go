@@ -0,0 +1,104 @@
+package redact
+
+import (
+	"strings"
+	"sync"
+)
+
+// Category groups identifying fields so that they can be redacted
+// selectively.
+type Category string
+
+// Categories of identifying fields.
+const (
+	Serial           Category = "serial"
+	Identifier       Category = "identifier"
+	MACAddress       Category = "mac_address"
+	BluetoothAddress Category = "bluetooth_address"
+	UserName         Category = "user_name"
+	HostName         Category = "host_name"
+	SSID             Category = "ssid"
+)
+
+// Categories lists every category, in the order they are documented.
+var Categories = []Category{Serial, Identifier, MACAddress, BluetoothAddress, UserName, HostName, SSID}
+
+// Field identifies a key in system_profiler output that holds identifying
+// data. Parent, when set, restricts the field to objects found under that
+// key, e.g. the "_name" of a Wi-Fi network is its SSID while the "_name" of
+// most other items is not identifying.
+type Field struct {
+	Key      string
+	Parent   string
+	Category Category
+}
+
+var (
+	fieldsMu sync.RWMutex
+
+	// fields is the registry of identifying keys. Keys are matched ignoring
+	// case, at any depth and in every data type.
+	fields = []Field{
+		// Serial numbers
+		{Key: "serial_number", Category: Serial},
+		{Key: "serial_num", Category: Serial},
+		{Key: "d_serial_num", Category: Serial},
+		{Key: "device_serial", Category: Serial},
+		{Key: "sppower_battery_serial_number", Category: Serial},
+		{Key: "spcardreader_card_serialnumber", Category: Serial},
+		{Key: "_spdisplays_display-serial-number", Category: Serial},
+
+		// Hardware and volume identifiers
+		{Key: "platform_UUID", Category: Identifier},
+		{Key: "provisioning_UDID", Category: Identifier},
+		{Key: "volume_uuid", Category: Identifier},
+		{Key: "ibridge_boot_uuid", Category: Identifier},
+		{Key: "scanner UUID", Category: Identifier},
+
+		// Network hardware addresses
+		{Key: "MAC Address", Category: MACAddress},
+		{Key: "spethernet_mac_address", Category: MACAddress},
+		{Key: "spairport_wireless_mac_address", Category: MACAddress},
+		{Key: "spairport_network_bssid", Category: MACAddress},
+
+		// Bluetooth addresses
+		{Key: "controller_address", Category: BluetoothAddress},
+		{Key: "device_address", Category: BluetoothAddress},
+
+		// Accounts and names of the Mac
+		{Key: "user_name", Category: UserName},
+		{Key: "spmanagedclient_user_name", Category: UserName},
+		{Key: "local_host_name", Category: HostName},
+
+		// Wi-Fi networks are named after their SSID
+		{Key: "_name", Parent: "spairport_current_network_information", Category: SSID},
+		{Key: "_name", Parent: "spairport_airport_other_local_wireless_networks", Category: SSID},
+	}
+)
+
+// Fields returns a copy of the registry.
+func Fields() []Field {
+	fieldsMu.RLock()
+	defer fieldsMu.RUnlock()
+	return append([]Field(nil), fields...)
+}
+
+// Register adds a field to the registry. It is meant to be called during
+// program initialization to cover keys the built-in registry does not know.
+func Register(f Field) {
+	fieldsMu.Lock()
+	defer fieldsMu.Unlock()
+	fields = append(fields, f)
+}
+
+// lookup returns the category of a key found under parent
+func lookup(key, parent string) (Category, bool) {
+	fieldsMu.RLock()
+	defer fieldsMu.RUnlock()
+	for _, f := range fields {
+		if strings.EqualFold(f.Key, key) && (f.Parent == "" || strings.EqualFold(f.Parent, parent)) {
+			return f.Category, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,139 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// cycloneDX is a CycloneDX 1.5 JSON document
+type cycloneDX struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+// cycloneDXMetadata describes the document and the Mac it was made for
+type cycloneDXMetadata struct {
+	Timestamp string              `json:"timestamp"`
+	Tools     cycloneDXTools      `json:"tools"`
+	Component *cycloneDXComponent `json:"component,omitempty"`
+}
+
+// cycloneDXTools lists the tools that produced the document
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+// cycloneDXComponent is a CycloneDX component
+type cycloneDXComponent struct {
+	Type       string              `json:"type"`
+	BOMRef     string              `json:"bom-ref,omitempty"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	Publisher  string              `json:"publisher,omitempty"`
+	Supplier   *cycloneDXSupplier  `json:"supplier,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+// cycloneDXSupplier is the organization that supplied a component
+type cycloneDXSupplier struct {
+	Name string `json:"name"`
+}
+
+// cycloneDXProperty is a name and value pair
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// cycloneDXTypes maps component kinds to CycloneDX component types
+var cycloneDXTypes = map[Kind]string{
+	KindApplication: "application",
+	KindFramework:   "framework",
+	KindExtension:   "device-driver",
+	KindPackage:     "application",
+}
+
+// WriteCycloneDX writes the inventory as a CycloneDX 1.5 JSON document. The
+// code signing chain, path, origin and install date of each component are
+// stored as "macos:" properties.
+func WriteCycloneDX(w io.Writer, inv *Inventory) error {
+	doc := cycloneDX{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + newUUID(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: inv.Timestamp.UTC().Format(time.RFC3339),
+			Tools: cycloneDXTools{Components: []cycloneDXComponent{
+				{Type: "application", Name: toolName},
+			}},
+		},
+		Components: make([]cycloneDXComponent, 0, len(inv.Components)),
+	}
+	if inv.OSName != "" {
+		doc.Metadata.Component = &cycloneDXComponent{
+			Type:    "operating-system",
+			Name:    inv.OSName,
+			Version: inv.OSVersion,
+		}
+		if inv.OSBuild != "" {
+			doc.Metadata.Component.Properties = append(doc.Metadata.Component.Properties, cycloneDXProperty{"macos:build", inv.OSBuild})
+		}
+		if inv.Model != "" {
+			doc.Metadata.Component.Properties = append(doc.Metadata.Component.Properties, cycloneDXProperty{"macos:model", inv.Model})
+		}
+	}
+
+	for i, c := range inv.Components {
+		component := cycloneDXComponent{
+			Type:      cycloneDXTypes[c.Kind],
+			BOMRef:    fmt.Sprintf("%s-%d", c.Kind, i+1),
+			Name:      c.Name,
+			Version:   c.Version,
+			Publisher: c.Signer(),
+			PURL:      c.PURL(),
+		}
+		if supplier := c.Supplier(); supplier != "" {
+			component.Supplier = &cycloneDXSupplier{Name: supplier}
+		}
+		for _, p := range properties(c) {
+			component.Properties = append(component.Properties, cycloneDXProperty{p[0], p[1]})
+		}
+		doc.Components = append(doc.Components, component)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// properties returns the metadata of a component as name and value pairs
+func properties(c Component) [][2]string {
+	var props [][2]string
+	if c.Path != "" {
+		props = append(props, [2]string{"macos:path", c.Path})
+	}
+	if c.BundleID != "" {
+		props = append(props, [2]string{"macos:bundle_id", c.BundleID})
+	}
+	if c.ObtainedFrom != "" {
+		props = append(props, [2]string{"macos:obtained_from", c.ObtainedFrom})
+	}
+	if team := c.TeamID(); team != "" {
+		props = append(props, [2]string{"macos:team_id", team})
+	}
+	for _, signer := range c.SignedBy {
+		props = append(props, [2]string{"macos:signed_by", signer})
+	}
+	if !c.InstallDate.IsZero() {
+		props = append(props, [2]string{"macos:install_date", c.InstallDate.UTC().Format(time.RFC3339)})
+	}
+	return props
+}
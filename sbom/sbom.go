@@ -0,0 +1,269 @@
+// Package sbom builds software bills of materials from the Applications,
+// Frameworks, Extensions and Install History data types and writes them as
+// CycloneDX 1.5 or SPDX 2.3 JSON documents.
+//
+// Components are identified by purl-like identifiers of the form
+// pkg:generic/macos/<kind>/<name>@<version>, qualified with the bundle
+// identifier when system_profiler reports one. The code signing chain is
+// kept as component metadata.
+package sbom
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+	"github.com/samburba/go-system-profiler/v2/type/applications"
+	"github.com/samburba/go-system-profiler/v2/type/extensions"
+	"github.com/samburba/go-system-profiler/v2/type/frameworks"
+	"github.com/samburba/go-system-profiler/v2/type/hardware"
+	"github.com/samburba/go-system-profiler/v2/type/installhistory"
+	"github.com/samburba/go-system-profiler/v2/type/software"
+)
+
+// toolName identifies this library in generated documents
+const toolName = "go-system-profiler"
+
+// Types lists the data types an inventory is built from. Software and
+// Hardware only describe the Mac itself and are optional.
+var Types = []snapshot.SPDataType{
+	common.SPApplicationsDataType,
+	common.SPFrameworksDataType,
+	common.SPExtensionsDataType,
+	common.SPInstallHistoryDataType,
+	common.SPSoftwareDataType,
+	common.SPHardwareDataType,
+}
+
+// Kind is the kind of software a component describes.
+type Kind string
+
+// Component kinds.
+const (
+	KindApplication Kind = "application"
+	KindFramework   Kind = "framework"
+	KindExtension   Kind = "extension"
+	KindPackage     Kind = "package"
+)
+
+// Component is one piece of installed software.
+type Component struct {
+	Kind         Kind
+	Name         string
+	Version      string
+	Path         string
+	BundleID     string
+	ObtainedFrom string
+	SignedBy     []string
+	InstallDate  time.Time
+}
+
+// PURL returns the purl-like identifier of the component.
+func (c Component) PURL() string {
+	purl := "pkg:generic/macos/" + string(c.Kind) + "/" + escape(c.Name)
+	if c.Version != "" {
+		purl += "@" + escape(c.Version)
+	}
+	if c.BundleID != "" {
+		purl += "?bundle_id=" + escape(c.BundleID)
+	}
+	return purl
+}
+
+// Signer returns the leaf signing authority, or an empty string for
+// unsigned software.
+func (c Component) Signer() string {
+	if len(c.SignedBy) == 0 {
+		return ""
+	}
+	return c.SignedBy[0]
+}
+
+// Supplier returns the organization that signed the component, e.g.
+// "Example, Inc." for "Developer ID Application: Example, Inc. (ABCDE12345)".
+// It is empty when the signer does not name the developer.
+func (c Component) Supplier() string {
+	signer := c.Signer()
+	if c.ObtainedFrom == "apple" || signer == "Software Signing" {
+		return "Apple Inc."
+	}
+	for _, prefix := range []string{"Developer ID Application: ", "Developer ID Installer: "} {
+		if strings.HasPrefix(signer, prefix) {
+			name, _ := splitTeamID(strings.TrimPrefix(signer, prefix))
+			return name
+		}
+	}
+	return ""
+}
+
+// TeamID returns the Apple developer team identifier from the signer, if any.
+func (c Component) TeamID() string {
+	_, team := splitTeamID(c.Signer())
+	return team
+}
+
+// Inventory is the software installed on a Mac.
+type Inventory struct {
+	Timestamp  time.Time
+	OSName     string
+	OSVersion  string
+	OSBuild    string
+	Model      string
+	Components []Component
+}
+
+// FromSnapshot builds an inventory from the data types of Types present in
+// the snapshot. Install History is reduced to the latest installation of
+// every package.
+func FromSnapshot(s *snapshot.Snapshot) (*Inventory, error) {
+	inv := &Inventory{Timestamp: s.CollectedAt}
+	if inv.Timestamp.IsZero() {
+		inv.Timestamp = time.Now()
+	}
+	found := false
+
+	if s.Has(common.SPApplicationsDataType) {
+		found = true
+		apps, err := snapshot.Items[applications.DataTypeItem](s, common.SPApplicationsDataType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode applications: %w", err)
+		}
+		for _, app := range apps {
+			inv.Components = append(inv.Components, Component{
+				Kind:         KindApplication,
+				Name:         app.Name,
+				Version:      app.Version,
+				Path:         app.Path,
+				ObtainedFrom: normalize.Canonical(app.ObtainedFrom),
+				SignedBy:     app.SignedBy,
+			})
+		}
+	}
+
+	if s.Has(common.SPFrameworksDataType) {
+		found = true
+		items, err := snapshot.Items[frameworks.DataTypeItem](s, common.SPFrameworksDataType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode frameworks: %w", err)
+		}
+		for _, fw := range items {
+			inv.Components = append(inv.Components, Component{
+				Kind:         KindFramework,
+				Name:         fw.Name,
+				Version:      fw.Version,
+				Path:         fw.Path,
+				ObtainedFrom: normalize.Canonical(fw.ObtainedFrom),
+				SignedBy:     fw.SignedBy,
+			})
+		}
+	}
+
+	if s.Has(common.SPExtensionsDataType) {
+		found = true
+		items, err := snapshot.Items[extensions.DataTypeItem](s, common.SPExtensionsDataType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode extensions: %w", err)
+		}
+		for _, ext := range items {
+			inv.Components = append(inv.Components, Component{
+				Kind:         KindExtension,
+				Name:         ext.Name,
+				Version:      ext.Version,
+				Path:         ext.Path,
+				BundleID:     ext.BundleID,
+				ObtainedFrom: normalize.Canonical(ext.ObtainedFrom),
+				SignedBy:     ext.Signers(),
+			})
+		}
+	}
+
+	if s.Has(common.SPInstallHistoryDataType) {
+		found = true
+		items, err := snapshot.Items[installhistory.DataTypeItem](s, common.SPInstallHistoryDataType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode install history: %w", err)
+		}
+		for _, pkg := range installhistory.Latest(items) {
+			c := Component{
+				Kind:         KindPackage,
+				Name:         pkg.Name,
+				Version:      pkg.InstallVersion,
+				ObtainedFrom: normalize.Canonical(pkg.PackageSource),
+			}
+			if installed, err := pkg.InstallTime(); err == nil {
+				c.InstallDate = installed
+			}
+			inv.Components = append(inv.Components, c)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("snapshot contains none of %s, %s, %s or %s", common.SPApplicationsDataType,
+			common.SPFrameworksDataType, common.SPExtensionsDataType, common.SPInstallHistoryDataType)
+	}
+
+	if items, err := snapshot.Items[software.DataTypeItem](s, common.SPSoftwareDataType); err == nil && len(items) > 0 {
+		if v, err := items[0].ParsedOSVersion(); err == nil {
+			inv.OSName, inv.OSBuild = v.Name, v.Build
+			v.Name, v.Build = "", ""
+			inv.OSVersion = v.String()
+		}
+	}
+	if items, err := snapshot.Items[hardware.DataTypeItem](s, common.SPHardwareDataType); err == nil && len(items) > 0 {
+		inv.Model = items[0].MachineModel
+	}
+
+	sort.SliceStable(inv.Components, func(i, j int) bool {
+		a, b := inv.Components[i], inv.Components[j]
+		if a.Kind != b.Kind {
+			return kindOrder(a.Kind) < kindOrder(b.Kind)
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+	return inv, nil
+}
+
+// kindOrder orders components in documents
+func kindOrder(kind Kind) int {
+	switch kind {
+	case KindApplication:
+		return 0
+	case KindFramework:
+		return 1
+	case KindExtension:
+		return 2
+	}
+	return 3
+}
+
+// splitTeamID splits "Example, Inc. (ABCDE12345)" into the name and team ID
+func splitTeamID(signer string) (string, string) {
+	if strings.HasSuffix(signer, ")") {
+		if i := strings.LastIndex(signer, " ("); i >= 0 {
+			return signer[:i], signer[i+2 : len(signer)-1]
+		}
+	}
+	return signer, ""
+}
+
+// escape percent-encodes a purl segment; spaces become %20
+func escape(value string) string {
+	return strings.ReplaceAll(url.PathEscape(value), "+", "%2B")
+}
+
+// newUUID returns a random version 4 UUID
+var newUUID = func() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,119 @@
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+const fixture = `{
+	"SPApplicationsDataType": [
+		{"_name": "Slack", "path": "/Applications/Slack.app", "version": "4.36.140", "obtained_from": "identified_developer",
+		 "signed_by": ["Developer ID Application: Slack Technologies, Inc. (BQR82RBBHL)", "Developer ID Certification Authority", "Apple Root CA"]},
+		{"_name": "Safari", "path": "/Applications/Safari.app", "version": "17.5", "obtained_from": "apple",
+		 "signed_by": ["Software Signing", "Apple Code Signing Certification Authority", "Apple Root CA"]}
+	],
+	"SPExtensionsDataType": [{"_name": "_items", "_items": [
+		{"_name": "AppleUSBAudio", "spext_bundleid": "com.apple.driver.AppleUSBAudio", "spext_version": "630.5",
+		 "spext_path": "/System/Library/Extensions/AppleUSBAudio.kext", "spext_obtained_from": "spext_apple",
+		 "spext_signed_by": "Software Signing, Apple Code Signing Certification Authority, Apple Root CA"}
+	]}],
+	"SPInstallHistoryDataType": [{"_name": "_items", "_items": [
+		{"_name": "XProtectPlistConfigData", "install_date": "2024-04-02T10:00:00Z", "install_version": "2192", "package_source": "package_source_apple"},
+		{"_name": "XProtectPlistConfigData", "install_date": "2024-05-14T10:00:00Z", "install_version": "2193", "package_source": "package_source_apple"}
+	]}],
+	"SPSoftwareDataType": [{"_name": "os_overview", "os_version": "macOS 14.5 (23F79)"}],
+	"SPHardwareDataType": [{"_name": "hardware_overview", "machine_model": "Mac15,3"}]
+}`
+
+func testInventory(t *testing.T) *Inventory {
+	t.Helper()
+	s, err := snapshot.Parse([]byte(fixture))
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+	inv, err := FromSnapshot(s)
+	if err != nil {
+		t.Fatalf("Failed to build inventory: %v", err)
+	}
+	return inv
+}
+
+func TestFromSnapshot(t *testing.T) {
+	inv := testInventory(t)
+	if inv.OSName != "macOS" || inv.OSVersion != "14.5" || inv.OSBuild != "23F79" || inv.Model != "Mac15,3" {
+		t.Errorf("Unexpected system: %s %s %s %s", inv.OSName, inv.OSVersion, inv.OSBuild, inv.Model)
+	}
+	if len(inv.Components) != 4 {
+		t.Fatalf("Expected 4 components, got %+v", inv.Components)
+	}
+
+	slack := inv.Components[1]
+	if slack.Name != "Slack" || slack.Supplier() != "Slack Technologies, Inc." || slack.TeamID() != "BQR82RBBHL" {
+		t.Errorf("Unexpected Slack component: %+v", slack)
+	}
+	if purl := slack.PURL(); purl != "pkg:generic/macos/application/Slack@4.36.140" {
+		t.Errorf("Unexpected purl %q", purl)
+	}
+
+	kext := inv.Components[2]
+	if kext.PURL() != "pkg:generic/macos/extension/AppleUSBAudio@630.5?bundle_id=com.apple.driver.AppleUSBAudio" || kext.Supplier() != "Apple Inc." {
+		t.Errorf("Unexpected extension: %s, supplier %q", kext.PURL(), kext.Supplier())
+	}
+
+	pkg := inv.Components[3]
+	if pkg.Kind != KindPackage || pkg.Version != "2193" {
+		t.Errorf("Expected the latest install of the package, got %+v", pkg)
+	}
+
+	if _, err := FromSnapshot(&snapshot.Snapshot{}); err == nil {
+		t.Error("Expected an error for a snapshot without software")
+	}
+}
+
+func TestWriteCycloneDX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCycloneDX(&buf, testInventory(t)); err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+
+	var doc cycloneDX
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if doc.BOMFormat != "CycloneDX" || doc.SpecVersion != "1.5" || doc.Metadata.Component.Version != "14.5" {
+		t.Errorf("Unexpected document header: %+v", doc)
+	}
+	slack := doc.Components[1]
+	if slack.Supplier == nil || slack.Supplier.Name != "Slack Technologies, Inc." || slack.Publisher == "" {
+		t.Errorf("Expected signer metadata, got %+v", slack)
+	}
+	if doc.Components[2].Type != "device-driver" {
+		t.Errorf("Expected a device-driver for the extension, got %q", doc.Components[2].Type)
+	}
+}
+
+func TestWriteSPDX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteSPDX(&buf, testInventory(t)); err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+
+	var doc spdxDocument
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if doc.SPDXVersion != "SPDX-2.3" || len(doc.Packages) != 4 || len(doc.Relationships) != 4 {
+		t.Fatalf("Unexpected document: %+v", doc)
+	}
+	safari := doc.Packages[0]
+	if safari.Supplier != "Organization: Apple Inc." || safari.PrimaryPackagePurpose != "APPLICATION" ||
+		safari.ExternalRefs[0].ReferenceLocator != "pkg:generic/macos/application/Safari@17.5" {
+		t.Errorf("Unexpected package: %+v", safari)
+	}
+	if doc.Packages[3].PrimaryPackagePurpose != "INSTALL" {
+		t.Errorf("Expected install history as INSTALL, got %q", doc.Packages[3].PrimaryPackagePurpose)
+	}
+}
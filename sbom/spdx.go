@@ -0,0 +1,134 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// noAssertion is the SPDX value for unknown information
+const noAssertion = "NOASSERTION"
+
+// spdxDocument is an SPDX 2.3 JSON document
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+// spdxCreationInfo describes when and by what the document was created
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+	Comment  string   `json:"comment,omitempty"`
+}
+
+// spdxPackage is an SPDX package
+type spdxPackage struct {
+	Name                  string            `json:"name"`
+	SPDXID                string            `json:"SPDXID"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	Supplier              string            `json:"supplier"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	LicenseConcluded      string            `json:"licenseConcluded"`
+	LicenseDeclared       string            `json:"licenseDeclared"`
+	CopyrightText         string            `json:"copyrightText"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose"`
+	ExternalRefs          []spdxExternalRef `json:"externalRefs"`
+	Comment               string            `json:"comment,omitempty"`
+}
+
+// spdxExternalRef links a package to its purl
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+// spdxRelationship relates two SPDX elements
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// spdxPurposes maps component kinds to SPDX primary package purposes
+var spdxPurposes = map[Kind]string{
+	KindApplication: "APPLICATION",
+	KindFramework:   "FRAMEWORK",
+	KindExtension:   "OTHER",
+	KindPackage:     "INSTALL",
+}
+
+// WriteSPDX writes the inventory as an SPDX 2.3 JSON document. SPDX has no
+// field for code signatures, so the signing chain and path of each package
+// are recorded in its comment.
+func WriteSPDX(w io.Writer, inv *Inventory) error {
+	name := "macOS software inventory"
+	if inv.Model != "" {
+		name += " of " + inv.Model
+	}
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              name,
+		DocumentNamespace: "https://github.com/samburba/go-system-profiler/spdx/" + newUUID(),
+		CreationInfo: spdxCreationInfo{
+			Created:  inv.Timestamp.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + toolName},
+		},
+		Packages:      make([]spdxPackage, 0, len(inv.Components)),
+		Relationships: make([]spdxRelationship, 0, len(inv.Components)),
+	}
+	if inv.OSName != "" {
+		doc.CreationInfo.Comment = strings.TrimSpace(fmt.Sprintf("%s %s %s", inv.OSName, inv.OSVersion, inv.OSBuild))
+	}
+
+	for i, c := range inv.Components {
+		id := fmt.Sprintf("SPDXRef-%s-%d", c.Kind, i+1)
+		supplier := noAssertion
+		if s := c.Supplier(); s != "" {
+			supplier = "Organization: " + s
+		}
+		var comment []string
+		for _, p := range properties(c) {
+			comment = append(comment, strings.TrimPrefix(p[0], "macos:")+": "+p[1])
+		}
+
+		doc.Packages = append(doc.Packages, spdxPackage{
+			Name:                  c.Name,
+			SPDXID:                id,
+			VersionInfo:           c.Version,
+			Supplier:              supplier,
+			DownloadLocation:      noAssertion,
+			LicenseConcluded:      noAssertion,
+			LicenseDeclared:       noAssertion,
+			CopyrightText:         noAssertion,
+			PrimaryPackagePurpose: spdxPurposes[c.Kind],
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  c.PURL(),
+			}},
+			Comment: strings.Join(comment, "\n"),
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: id,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
@@ -0,0 +1,203 @@
+// Package snapshot collects several system profiler data types in a single
+// system_profiler run and decodes them into the typed structures of the type
+// packages. A Snapshot can be saved as JSON and loaded again later, which
+// allows working with data captured on another Mac.
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+)
+
+// SPDataType identifies a system profiler data type such as "SPAudioDataType".
+type SPDataType = common.SPDataType
+
+// DetailLevel controls how much information system_profiler reports.
+type DetailLevel = common.DetailLevel
+
+// Detail levels accepted by system_profiler.
+const (
+	DetailMini  = common.DetailMini
+	DetailBasic = common.DetailBasic
+	DetailFull  = common.DetailFull
+)
+
+// Options controls how system_profiler is invoked. A zero Timeout uses the
+// system_profiler default.
+type Options struct {
+	DetailLevel DetailLevel
+	Timeout     time.Duration
+}
+
+// Snapshot holds the raw system_profiler output for a set of data types.
+type Snapshot struct {
+	CollectedAt time.Time                      `json:"collected_at"`
+	Data        map[SPDataType]json.RawMessage `json:"data"`
+}
+
+// Collect runs system_profiler once for the given data types and returns the
+// result. When no types are given, every supported data type is collected.
+func Collect(ctx context.Context, opts Options, types ...SPDataType) (*Snapshot, error) {
+	if len(types) == 0 {
+		types = common.AllSPDataTypes
+	}
+	output, err := common.Run(ctx, common.Options{DetailLevel: opts.DetailLevel, Timeout: opts.Timeout}, types...)
+	if err != nil {
+		return nil, err
+	}
+	s, err := Parse(output)
+	if err != nil {
+		return nil, err
+	}
+	s.CollectedAt = time.Now()
+	return s, nil
+}
+
+// Parse decodes either a saved Snapshot or the raw output of
+// "system_profiler -json".
+func Parse(data []byte) (*Snapshot, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	if _, ok := fields["data"]; ok {
+		var s Snapshot
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, fmt.Errorf("failed to parse snapshot: %w", err)
+		}
+		if s.Data == nil {
+			s.Data = map[SPDataType]json.RawMessage{}
+		}
+		return &s, nil
+	}
+
+	s := &Snapshot{Data: make(map[SPDataType]json.RawMessage, len(fields))}
+	for name, raw := range fields {
+		s.Data[SPDataType(name)] = raw
+	}
+	return s, nil
+}
+
+// Load reads a Snapshot, or raw "system_profiler -json" output, from a file.
+func Load(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	return Parse(data)
+}
+
+// Types returns the data types present in the snapshot, sorted by name.
+func (s *Snapshot) Types() []SPDataType {
+	types := make([]SPDataType, 0, len(s.Data))
+	for spType := range s.Data {
+		types = append(types, spType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// Has reports whether the snapshot contains the given data type.
+func (s *Snapshot) Has(spType SPDataType) bool {
+	_, ok := s.Data[spType]
+	return ok
+}
+
+// Get decodes a data type into the item type of its type package, for
+// example []audio.DataTypeItem for SPAudioDataType.
+func (s *Snapshot) Get(spType SPDataType) (interface{}, error) {
+	decode, ok := decoders[spType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported data type %s", spType)
+	}
+	raw, ok := s.Data[spType]
+	if !ok {
+		return nil, fmt.Errorf("no data found for %s", spType)
+	}
+	return decode(raw)
+}
+
+// Decoded decodes every supported data type in the snapshot, keyed by type.
+func (s *Snapshot) Decoded() (map[SPDataType]interface{}, error) {
+	out := make(map[SPDataType]interface{}, len(s.Data))
+	for _, spType := range s.Types() {
+		if _, ok := decoders[spType]; !ok {
+			continue
+		}
+		items, err := s.Get(spType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", spType, err)
+		}
+		out[spType] = items
+	}
+	return out, nil
+}
+
+// Items decodes a data type of the snapshot into items of type T. Entries
+// that wrap their items in "_items" are flattened, so T is always the
+// DataTypeItem of the matching type package. When T declares an "_items"
+// field itself, as the controller items of nvme or serialata do, the
+// entries are decoded as they are.
+func Items[T any](s *Snapshot, spType SPDataType) ([]T, error) {
+	raw, ok := s.Data[spType]
+	if !ok {
+		return nil, fmt.Errorf("no data found for %s", spType)
+	}
+	return decodeItems[T](raw)
+}
+
+// decodeItems decodes the entries of a data type into a slice of T,
+// flattening their "_items" unless T declares an "_items" field itself
+func decodeItems[T any](raw json.RawMessage) ([]T, error) {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	flatten := !hasItemsField(reflect.TypeOf((*T)(nil)).Elem())
+	items := []T{}
+	for _, entry := range entries {
+		if flatten {
+			var wrapper struct {
+				Items []T `json:"_items"`
+			}
+			if err := json.Unmarshal(entry, &wrapper); err == nil && wrapper.Items != nil {
+				items = append(items, wrapper.Items...)
+				continue
+			}
+		}
+		var item T
+		if err := json.Unmarshal(entry, &item); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// hasItemsField reports whether a struct type declares an "_items" field,
+// either directly or through an embedded struct
+func hasItemsField(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name == "_items" {
+			return true
+		}
+		if f.Anonymous && f.Tag.Get("json") == "" && hasItemsField(f.Type) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/type/applications"
+	"github.com/samburba/go-system-profiler/v2/type/audio"
+	"github.com/samburba/go-system-profiler/v2/type/nvme"
+	"github.com/samburba/go-system-profiler/v2/type/serialata"
+)
+
+const rawOutput = `{
+	"SPAudioDataType": [{
+		"_name": "coreaudio_device",
+		"_items": [
+			{"_name": "MacBook Pro Speakers", "coreaudio_device_transport": "coreaudio_device_type_builtin"},
+			{"_name": "USB Microphone", "coreaudio_device_transport": "coreaudio_device_type_usb"}
+		]
+	}],
+	"SPApplicationsDataType": [
+		{"_name": "Safari", "path": "/Applications/Safari.app", "version": "17.0"}
+	]
+}`
+
+func TestParseRawOutput(t *testing.T) {
+	s, err := Parse([]byte(rawOutput))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if types := s.Types(); len(types) != 2 || types[0] != common.SPApplicationsDataType {
+		t.Fatalf("Unexpected types: %v", types)
+	}
+
+	devices, err := Items[audio.DataTypeItem](s, common.SPAudioDataType)
+	if err != nil {
+		t.Fatalf("Failed to decode audio: %v", err)
+	}
+	if len(devices) != 2 || devices[1].Name != "USB Microphone" {
+		t.Errorf("Expected the _items to be flattened, got %+v", devices)
+	}
+
+	items, err := s.Get(common.SPApplicationsDataType)
+	if err != nil {
+		t.Fatalf("Failed to decode applications: %v", err)
+	}
+	apps, ok := items.([]applications.DataTypeItem)
+	if !ok || len(apps) != 1 || apps[0].Path != "/Applications/Safari.app" {
+		t.Errorf("Unexpected applications: %#v", items)
+	}
+
+	if _, err := s.Get(common.SPPowerDataType); err == nil {
+		t.Error("Expected an error for a missing data type")
+	}
+}
+
+const controllerOutput = `{
+	"SPNVMeDataType": [{
+		"_name": "Generic SSD Controller",
+		"_items": [{"_name": "APPLE SSD AP0512Z", "bsd_name": "disk0", "smart_status": "Verified"}]
+	}],
+	"SPSerialATADataType": [
+		{"_name": "AHCI Controller", "spsata_vendor": "Intel", "_items": [{"_name": "Samsung SSD 870", "bsd_name": "disk1"}]},
+		{"_name": "AHCI Controller 2", "spsata_vendor": "Intel", "_items": [{"_name": "WDC WD40EFRX", "bsd_name": "disk2"}]}
+	]
+}`
+
+func TestGetControllers(t *testing.T) {
+	s, err := Parse([]byte(controllerOutput))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	items, err := s.Get(common.SPNVMeDataType)
+	if err != nil {
+		t.Fatalf("Failed to decode nvme: %v", err)
+	}
+	controllers, ok := items.([]nvme.DataTypeItem)
+	if !ok || len(controllers) != 1 || controllers[0].Name != "Generic SSD Controller" {
+		t.Fatalf("Expected the NVMe controller, got %#v", items)
+	}
+	if len(controllers[0].Items) != 1 || controllers[0].Items[0].BsdName != "disk0" {
+		t.Errorf("Expected the drive under its controller, got %+v", controllers[0].Items)
+	}
+
+	items, err = s.Get(common.SPSerialATADataType)
+	if err != nil {
+		t.Fatalf("Failed to decode serialata: %v", err)
+	}
+	sata, ok := items.([]serialata.DataTypeItem)
+	if !ok || len(sata) != 2 {
+		t.Fatalf("Expected both SATA controllers, got %#v", items)
+	}
+	if sata[1].Name != "AHCI Controller 2" || sata[1].Vendor != "Intel" || len(sata[1].Items) != 1 || sata[1].Items[0].Name != "WDC WD40EFRX" {
+		t.Errorf("Unexpected SATA controller: %+v", sata[1])
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	s, err := Parse([]byte(rawOutput))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	loaded, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Failed to parse saved snapshot: %v", err)
+	}
+	if !loaded.Has(common.SPAudioDataType) || !loaded.Has(common.SPApplicationsDataType) {
+		t.Errorf("Saved snapshot lost data types: %v", loaded.Types())
+	}
+}
+
+func TestLookup(t *testing.T) {
+	cases := map[string]SPDataType{
+		"audio":            common.SPAudioDataType,
+		"SPAudioDataType":  common.SPAudioDataType,
+		"ibridge":          common.SPiBridgeDataType,
+		"PRINTERSSOFTWARE": common.SPPrintersSoftwareDataType,
+		"spnvmedatatype":   common.SPNVMeDataType,
+	}
+	for name, want := range cases {
+		if got, ok := Lookup(name); !ok || got != want {
+			t.Errorf("Lookup(%q) = %s, %v, want %s", name, got, ok, want)
+		}
+	}
+	if _, ok := Lookup("teleporter"); ok {
+		t.Error("Expected an unknown name to fail")
+	}
+	if len(Supported()) != len(common.AllSPDataTypes) {
+		t.Errorf("Expected a decoder for every data type, got %d of %d", len(Supported()), len(common.AllSPDataTypes))
+	}
+}
@@ -0,0 +1,150 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/type/airport"
+	"github.com/samburba/go-system-profiler/v2/type/applications"
+	"github.com/samburba/go-system-profiler/v2/type/audio"
+	"github.com/samburba/go-system-profiler/v2/type/bluetooth"
+	"github.com/samburba/go-system-profiler/v2/type/camera"
+	"github.com/samburba/go-system-profiler/v2/type/cardreader"
+	"github.com/samburba/go-system-profiler/v2/type/configurationprofile"
+	"github.com/samburba/go-system-profiler/v2/type/developertools"
+	"github.com/samburba/go-system-profiler/v2/type/diagnostics"
+	"github.com/samburba/go-system-profiler/v2/type/disabledsoftware"
+	"github.com/samburba/go-system-profiler/v2/type/discburning"
+	"github.com/samburba/go-system-profiler/v2/type/displays"
+	"github.com/samburba/go-system-profiler/v2/type/ethernet"
+	"github.com/samburba/go-system-profiler/v2/type/extensions"
+	"github.com/samburba/go-system-profiler/v2/type/fibrechannel"
+	"github.com/samburba/go-system-profiler/v2/type/firewall"
+	"github.com/samburba/go-system-profiler/v2/type/firewire"
+	"github.com/samburba/go-system-profiler/v2/type/fonts"
+	"github.com/samburba/go-system-profiler/v2/type/frameworks"
+	"github.com/samburba/go-system-profiler/v2/type/hardware"
+	"github.com/samburba/go-system-profiler/v2/type/ibridge"
+	"github.com/samburba/go-system-profiler/v2/type/installhistory"
+	"github.com/samburba/go-system-profiler/v2/type/international"
+	"github.com/samburba/go-system-profiler/v2/type/legacysoftware"
+	"github.com/samburba/go-system-profiler/v2/type/logs"
+	"github.com/samburba/go-system-profiler/v2/type/managedclient"
+	"github.com/samburba/go-system-profiler/v2/type/memory"
+	"github.com/samburba/go-system-profiler/v2/type/network"
+	"github.com/samburba/go-system-profiler/v2/type/networklocation"
+	"github.com/samburba/go-system-profiler/v2/type/networkvolume"
+	"github.com/samburba/go-system-profiler/v2/type/nvme"
+	"github.com/samburba/go-system-profiler/v2/type/parallelata"
+	"github.com/samburba/go-system-profiler/v2/type/parallelscsi"
+	"github.com/samburba/go-system-profiler/v2/type/pci"
+	"github.com/samburba/go-system-profiler/v2/type/power"
+	"github.com/samburba/go-system-profiler/v2/type/prefpane"
+	"github.com/samburba/go-system-profiler/v2/type/printers"
+	"github.com/samburba/go-system-profiler/v2/type/printerssoftware"
+	"github.com/samburba/go-system-profiler/v2/type/rawcamera"
+	"github.com/samburba/go-system-profiler/v2/type/sas"
+	"github.com/samburba/go-system-profiler/v2/type/secureelement"
+	"github.com/samburba/go-system-profiler/v2/type/serialata"
+	"github.com/samburba/go-system-profiler/v2/type/smartcards"
+	"github.com/samburba/go-system-profiler/v2/type/software"
+	"github.com/samburba/go-system-profiler/v2/type/spi"
+	"github.com/samburba/go-system-profiler/v2/type/startupitem"
+	"github.com/samburba/go-system-profiler/v2/type/storage"
+	"github.com/samburba/go-system-profiler/v2/type/syncservices"
+	"github.com/samburba/go-system-profiler/v2/type/thunderbolt"
+	"github.com/samburba/go-system-profiler/v2/type/universalaccess"
+	"github.com/samburba/go-system-profiler/v2/type/usb"
+)
+
+// decoders maps every supported data type to the item type of its package.
+var decoders = map[SPDataType]func(json.RawMessage) (interface{}, error){
+	common.SPAirPortDataType:              decode[airport.DataTypeItem],
+	common.SPApplicationsDataType:         decode[applications.DataTypeItem],
+	common.SPAudioDataType:                decode[audio.DataTypeItem],
+	common.SPBluetoothDataType:            decode[bluetooth.DataTypeItem],
+	common.SPCameraDataType:               decode[camera.DataTypeItem],
+	common.SPCardReaderDataType:           decode[cardreader.DataTypeItem],
+	common.SPConfigurationProfileDataType: decode[configurationprofile.DataTypeItem],
+	common.SPDeveloperToolsDataType:       decode[developertools.DataTypeItem],
+	common.SPDiagnosticsDataType:          decode[diagnostics.DataTypeItem],
+	common.SPDisabledSoftwareDataType:     decode[disabledsoftware.DataTypeItem],
+	common.SPDiscBurningDataType:          decode[discburning.DataTypeItem],
+	common.SPDisplaysDataType:             decode[displays.DataTypeItem],
+	common.SPEthernetDataType:             decode[ethernet.DataTypeItem],
+	common.SPExtensionsDataType:           decode[extensions.DataTypeItem],
+	common.SPFibreChannelDataType:         decode[fibrechannel.DataTypeItem],
+	common.SPFirewallDataType:             decode[firewall.DataTypeItem],
+	common.SPFireWireDataType:             decode[firewire.DataTypeItem],
+	common.SPFontsDataType:                decode[fonts.DataTypeItem],
+	common.SPFrameworksDataType:           decode[frameworks.DataTypeItem],
+	common.SPHardwareDataType:             decode[hardware.DataTypeItem],
+	common.SPiBridgeDataType:              decode[ibridge.DataTypeItem],
+	common.SPInstallHistoryDataType:       decode[installhistory.DataTypeItem],
+	common.SPInternationalDataType:        decode[international.DataTypeItem],
+	common.SPLegacySoftwareDataType:       decode[legacysoftware.DataTypeItem],
+	common.SPLogsDataType:                 decode[logs.DataTypeItem],
+	common.SPManagedClientDataType:        decode[managedclient.DataTypeItem],
+	common.SPMemoryDataType:               decode[memory.DataTypeItem],
+	common.SPNetworkDataType:              decode[network.DataTypeItem],
+	common.SPNetworkLocationDataType:      decode[networklocation.DataTypeItem],
+	common.SPNetworkVolumeDataType:        decode[networkvolume.DataTypeItem],
+	common.SPNVMeDataType:                 decode[nvme.DataTypeItem],
+	common.SPParallelATADataType:          decode[parallelata.DataTypeItem],
+	common.SPParallelSCSIDataType:         decode[parallelscsi.DataTypeItem],
+	common.SPPCIDataType:                  decode[pci.DataTypeItem],
+	common.SPPowerDataType:                decode[power.DataTypeItem],
+	common.SPPrefPaneDataType:             decode[prefpane.DataTypeItem],
+	common.SPPrintersDataType:             decode[printers.DataTypeItem],
+	common.SPPrintersSoftwareDataType:     decode[printerssoftware.DataTypeItem],
+	common.SPRawCameraDataType:            decode[rawcamera.DataTypeItem],
+	common.SPSASDataType:                  decode[sas.DataTypeItem],
+	common.SPSecureElementDataType:        decode[secureelement.DataTypeItem],
+	common.SPSerialATADataType:            decode[serialata.DataTypeItem],
+	common.SPSmartCardsDataType:           decode[smartcards.DataTypeItem],
+	common.SPSoftwareDataType:             decode[software.DataTypeItem],
+	common.SPSPIDataType:                  decode[spi.DataTypeItem],
+	common.SPStartupItemDataType:          decode[startupitem.DataTypeItem],
+	common.SPStorageDataType:              decode[storage.DataTypeItem],
+	common.SPSyncServicesDataType:         decode[syncservices.DataTypeItem],
+	common.SPThunderboltDataType:          decode[thunderbolt.DataTypeItem],
+	common.SPUniversalAccessDataType:      decode[universalaccess.DataTypeItem],
+	common.SPUSBDataType:                  decode[usb.DataTypeItem],
+}
+
+// decode returns the flattened items of a data type as []T
+func decode[T any](raw json.RawMessage) (interface{}, error) {
+	return decodeItems[T](raw)
+}
+
+// Supported returns every data type the snapshot package can decode, sorted
+// by name.
+func Supported() []SPDataType {
+	types := make([]SPDataType, 0, len(decoders))
+	for spType := range decoders {
+		types = append(types, spType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// ShortName returns the name of the type package for a data type, for
+// example "audio" for SPAudioDataType.
+func ShortName(spType SPDataType) string {
+	name := strings.TrimPrefix(string(spType), "SP")
+	name = strings.TrimSuffix(name, "DataType")
+	return strings.ToLower(name)
+}
+
+// Lookup resolves a data type from its full name ("SPAudioDataType") or its
+// short name ("audio"), ignoring case.
+func Lookup(name string) (SPDataType, bool) {
+	for spType := range decoders {
+		if strings.EqualFold(string(spType), name) || strings.EqualFold(ShortName(spType), name) {
+			return spType, true
+		}
+	}
+	return "", false
+}
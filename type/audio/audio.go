@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPAudioDataType.
@@ -24,6 +25,28 @@ type DataTypeItem struct {
 	CoreaudioOutputSource             string `json:"coreaudio_output_source,omitempty"`
 }
 
+// Transport returns the canonical transport of the device, such as
+// normalize.BuiltIn, normalize.USB or normalize.Bluetooth, independent of
+// the system language.
+func (d DataTypeItem) Transport() string {
+	return normalize.Canonical(d.CoreaudioDeviceTransport)
+}
+
+// SampleRate returns the current sample rate of the device, e.g. 48 kHz.
+func (d DataTypeItem) SampleRate() normalize.Frequency {
+	return normalize.Frequency(d.CoreaudioDeviceSrate) * normalize.Hertz
+}
+
+// IsDefaultInput reports whether the device is the default input device.
+func (d DataTypeItem) IsDefaultInput() bool {
+	return normalize.Bool(d.CoreaudioDefaultAudioInputDevice)
+}
+
+// IsDefaultOutput reports whether the device is the default output device.
+func (d DataTypeItem) IsDefaultOutput() bool {
+	return normalize.Bool(d.CoreaudioDefaultAudioOutputDevice)
+}
+
 // DataType holds the parsed system profiler data for SPAudioDataType.
 var DataType *common.DataType[DataTypeItem]
 
@@ -117,3 +117,25 @@ func TestGetDataType(t *testing.T) {
 		t.Error("GetDataType should return the same instance as DataType")
 	}
 }
+
+func TestAudioTransport(t *testing.T) {
+	jsonData := []byte(`{
+		"_name": "MacBook Pro Speakers",
+		"coreaudio_default_audio_output_device": "spaudio_yes",
+		"coreaudio_device_srate": 48000,
+		"coreaudio_device_transport": "coreaudio_device_type_builtin"
+	}`)
+	var item DataTypeItem
+	if err := json.Unmarshal(jsonData, &item); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if item.Transport() != "builtin" {
+		t.Errorf("Expected builtin transport, got %q", item.Transport())
+	}
+	if !item.IsDefaultOutput() || item.IsDefaultInput() {
+		t.Error("Expected the default output device only")
+	}
+	if rate := item.SampleRate(); rate.String() != "48 kHz" {
+		t.Errorf("Expected 48 kHz, got %s", rate)
+	}
+}
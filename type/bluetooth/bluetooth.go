@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // ControllerProperties represents Bluetooth controller properties.
@@ -21,6 +22,16 @@ type ControllerProperties struct {
 	ControllerVendorID          string `json:"controller_vendorID,omitempty"`
 }
 
+// PoweredOn reports whether the controller is on, decoding values such as "attrib_on".
+func (c ControllerProperties) PoweredOn() bool {
+	return normalize.Bool(c.ControllerState)
+}
+
+// Discoverable reports whether the controller is discoverable.
+func (c ControllerProperties) Discoverable() bool {
+	return normalize.Bool(c.ControllerDiscoverable)
+}
+
 // DeviceNotConnected represents a Bluetooth device that is not currently connected.
 type DeviceNotConnected struct {
 	DeviceAddress   string `json:"device_address,omitempty"`
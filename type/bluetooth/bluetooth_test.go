@@ -71,3 +71,13 @@ func TestBluetoothControllerProperties(t *testing.T) {
 	// This test just verifies the basic functionality works
 	t.Log("Bluetooth data structure test passed")
 }
+
+func TestBluetoothControllerState(t *testing.T) {
+	controller := ControllerProperties{ControllerState: "attrib_on", ControllerDiscoverable: "attrib_off"}
+	if !controller.PoweredOn() {
+		t.Error("Expected the controller to be on")
+	}
+	if controller.Discoverable() {
+		t.Error("Expected the controller not to be discoverable")
+	}
+}
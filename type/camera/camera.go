@@ -3,6 +3,7 @@ package camera
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
@@ -15,6 +16,29 @@ type DataTypeItem struct {
 	SpcameraUniqueID string `json:"spcamera_unique-id,omitempty"`
 }
 
+// IsBuiltIn reports whether the camera is a built-in FaceTime camera.
+func (d DataTypeItem) IsBuiltIn() bool {
+	return strings.Contains(d.Name, "FaceTime") || strings.Contains(d.SpcameraModelID, "FaceTime")
+}
+
+// IsContinuityCamera reports whether the camera is an iPhone used as a
+// Continuity Camera; its model ID is the iPhone model, e.g. "iPhone15,2".
+func (d DataTypeItem) IsContinuityCamera() bool {
+	return strings.HasPrefix(d.SpcameraModelID, "iPhone")
+}
+
+// External returns the cameras that are neither built in nor Continuity
+// Cameras, such as USB webcams.
+func External(items []DataTypeItem) []DataTypeItem {
+	var external []DataTypeItem
+	for _, item := range items {
+		if !item.IsBuiltIn() && !item.IsContinuityCamera() {
+			external = append(external, item)
+		}
+	}
+	return external
+}
+
 // DataType holds the parsed system profiler data for SPCameraDataType.
 var DataType *common.DataType[DataTypeItem]
 
@@ -26,7 +50,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPCameraDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPCameraDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize camera data: %w", err)
 			return
@@ -3,6 +3,8 @@ package camera
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
 func TestCameraDataType(t *testing.T) {
@@ -71,3 +73,30 @@ func TestCameraFields(t *testing.T) {
 		t.Logf("Camera device %d: %s", i, item.Name)
 	}
 }
+
+func TestCameraKinds(t *testing.T) {
+	output := `{"SPCameraDataType": [
+		{"_name": "FaceTime HD Camera", "spcamera_model-id": "FaceTime HD Camera", "spcamera_unique-id": "47B4B64B70674B9CAD2BAE273A71F4B5"},
+		{"_name": "Sam's iPhone Camera", "spcamera_model-id": "iPhone15,2", "spcamera_unique-id": "A3C5E0F2-1B2D-4C6E-8F90-123456789ABC"},
+		{"_name": "Logitech BRIO", "spcamera_model-id": "UVC Camera VendorID_1133 ProductID_2181", "spcamera_unique-id": "0x1410000046d085e"}
+	]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPCameraDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse camera data: %v", err)
+	}
+	if len(data.Item) != 3 {
+		t.Fatalf("Expected every camera, got %d", len(data.Item))
+	}
+
+	builtIn, iPhone := data.Item[0], data.Item[1]
+	if !builtIn.IsBuiltIn() || builtIn.IsContinuityCamera() {
+		t.Errorf("Expected %s to be built in", builtIn.Name)
+	}
+	if !iPhone.IsContinuityCamera() || iPhone.IsBuiltIn() {
+		t.Errorf("Expected %s to be a Continuity Camera", iPhone.Name)
+	}
+	if external := External(data.Item); len(external) != 1 || external[0].Name != "Logitech BRIO" {
+		t.Errorf("Unexpected external cameras: %+v", external)
+	}
+}
@@ -5,13 +5,44 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/samburba/go-system-profiler/v2/disk"
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// Media represents a card inserted into a card reader.
+type Media struct {
+	disk.Device
+	ManufacturerID string `json:"spcardreader_card_manfid,omitempty"`
+	ProductName    string `json:"spcardreader_card_productname,omitempty"`
+	ProductRev     string `json:"spcardreader_card_productrev,omitempty"`
+	SerialNumber   string `json:"spcardreader_card_serialnumber,omitempty"`
+	SpecVersion    string `json:"spcardreader_card_specversion,omitempty"`
+	CardType       string `json:"spcardreader_card_type,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPCardReaderDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name              string  `json:"_name"`
+	Items             []Media `json:"_items,omitempty"`
+	DeviceID          string  `json:"spcardreader_device-id,omitempty"`
+	LinkSpeed         string  `json:"spcardreader_link-speed,omitempty"`
+	LinkWidth         string  `json:"spcardreader_link-width,omitempty"`
+	RevisionID        string  `json:"spcardreader_revision-id,omitempty"`
+	SubsystemID       string  `json:"spcardreader_subsystem-id,omitempty"`
+	SubsystemVendorID string  `json:"spcardreader_subsystem-vendor-id,omitempty"`
+	VendorID          string  `json:"spcardreader_vendor-id,omitempty"`
+}
+
+// HasMedia reports whether a card is inserted into the reader.
+func (d DataTypeItem) HasMedia() bool {
+	return len(d.Items) > 0
+}
+
+// Speed returns the PCIe link speed of the reader. Rates reported in GT/s,
+// such as "2.5 GT/s", are read as Gb/s.
+func (d DataTypeItem) Speed() (normalize.Speed, error) {
+	return normalize.ParseSpeed(d.LinkSpeed)
 }
 
 // DataType holds the parsed system profiler data for SPCardReaderDataType.
@@ -25,7 +56,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPCardReaderDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPCardReaderDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize cardreader data: %w", err)
 			return
@@ -71,3 +71,39 @@ func TestCardReaderFields(t *testing.T) {
 		t.Logf("Card reader device %d: %s", i, item.Name)
 	}
 }
+
+func TestCardReaderMedia(t *testing.T) {
+	input := `{
+		"_name": "Built in SD Card Reader",
+		"spcardreader_device-id": "0x170d",
+		"spcardreader_vendor-id": "0x14e4",
+		"spcardreader_revision-id": "0x0001",
+		"spcardreader_link-speed": "2.5 GT/s",
+		"_items": [
+			{
+				"_name": "SDXC Reader",
+				"bsd_name": "disk4",
+				"removable_media": "yes",
+				"size_in_bytes": 63864569856,
+				"spcardreader_card_productname": "SD64G",
+				"spcardreader_card_type": "SDXC"
+			}
+		]
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal card reader: %v", err)
+	}
+
+	if !item.HasMedia() {
+		t.Fatal("Expected inserted media")
+	}
+	if speed, err := item.Speed(); err != nil || speed.Gbps() != 2.5 {
+		t.Errorf("Expected a 2.5 GT/s link, got %v (%v)", speed, err)
+	}
+	media := item.Items[0]
+	if media.BsdName != "disk4" || !media.IsRemovable() || media.CardType != "SDXC" {
+		t.Errorf("Unexpected media: %+v", media)
+	}
+}
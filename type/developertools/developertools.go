@@ -3,15 +3,123 @@ package developertools
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// Platform identifies an SDK platform.
+type Platform string
+
+const (
+	PlatformMacOS     Platform = "macOS"
+	PlatformIOS       Platform = "iOS"
+	PlatformWatchOS   Platform = "watchOS"
+	PlatformTvOS      Platform = "tvOS"
+	PlatformVisionOS  Platform = "visionOS"
+	PlatformDriverKit Platform = "DriverKit"
+)
+
+// Apps represents the versions of the developer applications.
+type Apps struct {
+	Instruments      string `json:"spinstruments_app,omitempty"`
+	Xcode            string `json:"spxcode_app,omitempty"`
+	CommandLineTools string `json:"spcommandlinetools_app,omitempty"`
+}
+
+// SDK represents an installed SDK.
+type SDK struct {
+	Platform  Platform          `json:"platform"`
+	Simulator bool              `json:"simulator,omitempty"`
+	Version   normalize.Version `json:"version"`
+}
+
 // DataTypeItem represents the structure of SPDeveloperToolsDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name    string                       `json:"_name"`
+	Apps    Apps                         `json:"spdevtools_apps,omitempty"`
+	Path    string                       `json:"spdevtools_path,omitempty"`
+	SDKs    map[string]map[string]string `json:"spdevtools_sdks,omitempty"`
+	Version string                       `json:"spdevtools_version,omitempty"`
+}
+
+// XcodeVersion returns the parsed Xcode version.
+func (d DataTypeItem) XcodeVersion() (normalize.Version, error) {
+	if d.Apps.Xcode != "" {
+		return normalize.ParseVersion(d.Apps.Xcode)
+	}
+	return normalize.ParseVersion(d.Version)
+}
+
+// InstrumentsVersion returns the parsed Instruments version.
+func (d DataTypeItem) InstrumentsVersion() (normalize.Version, error) {
+	return normalize.ParseVersion(d.Apps.Instruments)
+}
+
+// CommandLineToolsVersion returns the parsed Command Line Tools version.
+func (d DataTypeItem) CommandLineToolsVersion() (normalize.Version, error) {
+	return normalize.ParseVersion(d.Apps.CommandLineTools)
+}
+
+// InstalledSDKs returns every installed SDK sorted by platform and version.
+// SDKs whose version cannot be parsed are skipped.
+func (d DataTypeItem) InstalledSDKs() []SDK {
+	var sdks []SDK
+	for group, entries := range d.SDKs {
+		simulator := strings.Contains(strings.ToLower(group), "simulator")
+		for name, build := range entries {
+			platform, version, found := strings.Cut(strings.TrimSpace(name), " ")
+			if !found {
+				continue
+			}
+			v, err := normalize.ParseVersion(version + " " + build)
+			if err != nil {
+				continue
+			}
+			sdks = append(sdks, SDK{Platform: normalizePlatform(platform), Simulator: simulator, Version: v})
+		}
+	}
+	sort.Slice(sdks, func(i, j int) bool {
+		if sdks[i].Platform != sdks[j].Platform {
+			return sdks[i].Platform < sdks[j].Platform
+		}
+		if sdks[i].Simulator != sdks[j].Simulator {
+			return !sdks[i].Simulator
+		}
+		return sdks[i].Version.Compare(sdks[j].Version) < 0
+	})
+	return sdks
+}
+
+// LatestSDK returns the newest device SDK installed for platform.
+func (d DataTypeItem) LatestSDK(platform Platform) (SDK, bool) {
+	var latest SDK
+	found := false
+	for _, sdk := range d.InstalledSDKs() {
+		if sdk.Platform != platform || sdk.Simulator {
+			continue
+		}
+		if !found || sdk.Version.Compare(latest.Version) > 0 {
+			latest, found = sdk, true
+		}
+	}
+	return latest, found
+}
+
+// normalizePlatform maps SDK name prefixes to a Platform.
+func normalizePlatform(name string) Platform {
+	for _, p := range []Platform{PlatformMacOS, PlatformIOS, PlatformWatchOS, PlatformTvOS, PlatformVisionOS, PlatformDriverKit} {
+		if strings.EqualFold(name, string(p)) {
+			return p
+		}
+	}
+	if strings.EqualFold(name, "xrOS") {
+		return PlatformVisionOS
+	}
+	return Platform(name)
 }
 
 // DataType holds the parsed system profiler data for SPDeveloperToolsDataType.
@@ -71,3 +71,67 @@ func TestDeveloperToolsFields(t *testing.T) {
 		t.Logf("Developer tools %d: %s", i, item.Name)
 	}
 }
+
+func TestDeveloperToolsVersions(t *testing.T) {
+	input := `{
+		"_name": "spdevtools_information",
+		"spdevtools_apps": {
+			"spinstruments_app": "15.4 (15F31d)",
+			"spxcode_app": "15.4 (15F31d)"
+		},
+		"spdevtools_path": "/Applications/Xcode.app/Contents/Developer",
+		"spdevtools_sdks": {
+			"iOS": {"iOS 17.5": "(21F77)"},
+			"iOS Simulator": {"iOS 17.5": "(21F77)"},
+			"macOS": {"macOS 14.4": "(23E208)", "macOS 14.5": "(23F73)"},
+			"visionOS": {"xrOS 1.2": "(21O5565)"}
+		},
+		"spdevtools_version": "15.4 (15F31d)"
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal developer tools: %v", err)
+	}
+
+	xcode, err := item.XcodeVersion()
+	if err != nil {
+		t.Fatalf("Failed to parse Xcode version: %v", err)
+	}
+	if xcode.Major != 15 || xcode.Minor != 4 || xcode.Build != "15F31d" {
+		t.Errorf("Unexpected Xcode version: %+v", xcode)
+	}
+
+	for minimum, want := range map[string]bool{"15.0": true, "15.4": true, "15.4.1": false, "16": false} {
+		got, err := xcode.AtLeast(minimum)
+		if err != nil {
+			t.Fatalf("AtLeast(%q) returned error: %v", minimum, err)
+		}
+		if got != want {
+			t.Errorf("AtLeast(%q) = %v, want %v", minimum, got, want)
+		}
+	}
+
+	if sdks := item.InstalledSDKs(); len(sdks) != 5 {
+		t.Errorf("Expected 5 SDKs, got %d: %+v", len(sdks), sdks)
+	}
+
+	macOS, ok := item.LatestSDK(PlatformMacOS)
+	if !ok || macOS.Version.Minor != 5 {
+		t.Errorf("Expected latest macOS SDK 14.5, got %+v", macOS)
+	}
+	if _, ok := item.LatestSDK(PlatformVisionOS); !ok {
+		t.Error("Expected a visionOS SDK")
+	}
+	if _, ok := item.LatestSDK(PlatformTvOS); ok {
+		t.Error("Expected no tvOS SDK")
+	}
+}
+
+func TestXcodeVersionInvalid(t *testing.T) {
+	for _, input := range []string{"", "abc", "1.2.3.4.5"} {
+		if _, err := (DataTypeItem{Version: input}).XcodeVersion(); err == nil {
+			t.Errorf("XcodeVersion() for %q should fail", input)
+		}
+	}
+}
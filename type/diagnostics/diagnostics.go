@@ -3,15 +3,28 @@ package diagnostics
 
 import (
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
 // DataTypeItem represents the structure of SPDiagnosticsDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name    string `json:"_name"`
+	LastRun string `json:"spdiags_last_run_key,omitempty"`
+	Result  string `json:"spdiags_result_key,omitempty"`
+}
+
+// Passed reports whether the self-test passed.
+func (d DataTypeItem) Passed() bool {
+	return strings.Contains(strings.ToLower(d.Result), "passed")
+}
+
+// LastRunTime returns the parsed time the self-test last ran.
+func (d DataTypeItem) LastRunTime() (time.Time, error) {
+	return common.ParseTimestamp(d.LastRun)
 }
 
 // DataType holds the parsed system profiler data for SPDiagnosticsDataType.
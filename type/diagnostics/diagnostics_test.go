@@ -71,3 +71,23 @@ func TestDiagnosticsFields(t *testing.T) {
 		t.Logf("Diagnostics %d: %s", i, item.Name)
 	}
 }
+
+func TestDiagnosticsResult(t *testing.T) {
+	input := `{"_name": "spdiags_post_value", "spdiags_last_run_key": "2024-05-01T07:15:00Z", "spdiags_result_key": "spdiags_passed_value"}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal diagnostics: %v", err)
+	}
+
+	if !item.Passed() {
+		t.Errorf("Expected self-test to pass, result %q", item.Result)
+	}
+	lastRun, err := item.LastRunTime()
+	if err != nil {
+		t.Fatalf("Failed to parse last run: %v", err)
+	}
+	if lastRun.Year() != 2024 || lastRun.Month() != 5 || lastRun.Hour() != 7 {
+		t.Errorf("Unexpected last run time: %v", lastRun)
+	}
+}
@@ -3,15 +3,47 @@ package disabledsoftware
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPDisabledSoftwareDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name           string             `json:"_name"`
+	ArchKind       normalize.ArchKind `json:"arch_kind,omitempty"`
+	DisabledReason string             `json:"disabled_reason,omitempty"`
+	Path           string             `json:"path,omitempty"`
+	Version        string             `json:"version,omitempty"`
+}
+
+// IsArchitectureReason reports whether the software was disabled because
+// its architecture is unsupported, e.g. 32-bit or PowerPC code, either as
+// stated in the reason or as reported by arch_kind.
+func (d DataTypeItem) IsArchitectureReason() bool {
+	if d.ArchKind.Is32Bit() {
+		return true
+	}
+	reason := strings.ToLower(d.DisabledReason)
+	for _, marker := range []string{"32-bit", "32 bit", "architecture", "powerpc", "ppc"} {
+		if strings.Contains(reason, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// IntelOnly returns the disabled entries that only contain Intel code.
+func IntelOnly(items []DataTypeItem) []DataTypeItem {
+	var matched []DataTypeItem
+	for _, item := range items {
+		if item.ArchKind.IsIntelOnly() {
+			matched = append(matched, item)
+		}
+	}
+	return matched
 }
 
 // DataType holds the parsed system profiler data for SPDisabledSoftwareDataType.
@@ -3,6 +3,8 @@ package disabledsoftware
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 func TestDisabledSoftwareDataType(t *testing.T) {
@@ -71,3 +73,25 @@ func TestDisabledSoftwareFields(t *testing.T) {
 		t.Logf("Disabled software %d: %s", i, item.Name)
 	}
 }
+
+func TestDisabledSoftwareReason(t *testing.T) {
+	items := []DataTypeItem{
+		{Name: "OldDriver", DisabledReason: "Unsupported 32-bit architecture"},
+		{Name: "BadKext", DisabledReason: "Known incompatible software"},
+		{Name: "OldHelper", ArchKind: normalize.ArchIntel32, DisabledReason: "Incompatible"},
+		{Name: "IntelTool", ArchKind: normalize.ArchIntel64, DisabledReason: "Incompatible"},
+	}
+
+	if !items[0].IsArchitectureReason() {
+		t.Errorf("Expected %s to be disabled for its architecture", items[0].Name)
+	}
+	if items[1].IsArchitectureReason() {
+		t.Errorf("Expected %s not to be disabled for its architecture", items[1].Name)
+	}
+	if !items[2].IsArchitectureReason() || items[3].IsArchitectureReason() {
+		t.Error("Expected only the 32-bit arch_kind to count as an architecture reason")
+	}
+	if intel := IntelOnly(items); len(intel) != 2 || intel[0].Name != "OldHelper" || intel[1].Name != "IntelTool" {
+		t.Errorf("Unexpected Intel-only entries: %+v", intel)
+	}
+}
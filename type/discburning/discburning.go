@@ -3,15 +3,69 @@ package discburning
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
-// DataTypeItem represents the structure of SPDiscBurningDataType.
+// DataTypeItem represents the structure of SPDiscBurningDataType. Each item
+// is an optical drive.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name             string `json:"_name"`
+	BDWrite          string `json:"device_bdwrite,omitempty"`
+	BurnSupport      string `json:"device_burnsupport,omitempty"`
+	Cache            string `json:"device_cache,omitempty"`
+	CDWrite          string `json:"device_cdwrite,omitempty"`
+	Connection       string `json:"device_connection,omitempty"`
+	DVDWrite         string `json:"device_dvdwrite,omitempty"`
+	FirmwareRevision string `json:"device_firmwarerevision,omitempty"`
+	Media            string `json:"device_media,omitempty"`
+	Reads            string `json:"device_reads,omitempty"`
+	WriteStrategies  string `json:"device_writestrategies,omitempty"`
+}
+
+// CDWriteFormats returns the CD formats the drive can write, e.g. "-R", "-RW".
+func (d DataTypeItem) CDWriteFormats() []string {
+	return splitList(d.CDWrite)
+}
+
+// DVDWriteFormats returns the DVD formats the drive can write.
+func (d DataTypeItem) DVDWriteFormats() []string {
+	return splitList(d.DVDWrite)
+}
+
+// BDWriteFormats returns the Blu-ray formats the drive can write.
+func (d DataTypeItem) BDWriteFormats() []string {
+	return splitList(d.BDWrite)
+}
+
+// WriteStrategyList returns the supported write strategies, e.g. "CD-TAO".
+func (d DataTypeItem) WriteStrategyList() []string {
+	return splitList(d.WriteStrategies)
+}
+
+// CanBurn reports whether the drive supports burning discs.
+func (d DataTypeItem) CanBurn() bool {
+	return normalize.Bool(d.BurnSupport)
+}
+
+// HasMedia reports whether a disc is inserted.
+func (d DataTypeItem) HasMedia() bool {
+	media := strings.ToLower(strings.TrimSpace(d.Media))
+	return media != "" && !strings.Contains(media, "no_media") && !strings.Contains(media, "insert media")
+}
+
+// splitList splits comma separated capability lists.
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
 }
 
 // DataType holds the parsed system profiler data for SPDiscBurningDataType.
@@ -25,7 +79,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPDiscBurningDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPDiscBurningDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize discburning data: %w", err)
 			return
@@ -0,0 +1,103 @@
+package discburning
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+)
+
+func TestDiscBurningDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No disc burning data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestDiscBurningCapabilities(t *testing.T) {
+	input := `{
+		"_name": "HL-DT-ST DVDRW GX50N",
+		"device_burnsupport": "spdisc_burn_support_yes_apple_shipping",
+		"device_cdwrite": "-R, -RW",
+		"device_dvdwrite": "-R, -R DL, -RW, +R, +R DL, +RW",
+		"device_media": "spdisc_no_media",
+		"device_writestrategies": "CD-TAO, CD-SAO, DVD-DAO"
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal disc burning data: %v", err)
+	}
+
+	if !item.CanBurn() {
+		t.Error("Expected the drive to support burning")
+	}
+	if item.HasMedia() {
+		t.Error("Expected no media")
+	}
+	if formats := item.CDWriteFormats(); len(formats) != 2 || formats[1] != "-RW" {
+		t.Errorf("Unexpected CD write formats: %v", formats)
+	}
+	if formats := item.DVDWriteFormats(); len(formats) != 6 {
+		t.Errorf("Unexpected DVD write formats: %v", formats)
+	}
+	if formats := item.BDWriteFormats(); len(formats) != 0 {
+		t.Errorf("Expected no Blu-ray write formats, got %v", formats)
+	}
+	if strategies := item.WriteStrategyList(); len(strategies) != 3 {
+		t.Errorf("Unexpected write strategies: %v", strategies)
+	}
+}
+
+func TestDiscBurningDrives(t *testing.T) {
+	output := `{"SPDiscBurningDataType": [
+		{
+			"_name": "HL-DT-ST DVDRW GX50N",
+			"device_burnsupport": "spdisc_burn_support_yes_apple_shipping",
+			"device_connection": "USB",
+			"device_media": "spdisc_no_media",
+			"device_writestrategies": "CD-TAO, CD-SAO, DVD-DAO"
+		},
+		{
+			"_name": "MATSHITA DVD-R UJ-8A8",
+			"device_burnsupport": "spdisc_no",
+			"device_connection": "ATAPI",
+			"device_media": "CD-ROM"
+		}
+	]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPDiscBurningDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse disc burning data: %v", err)
+	}
+	if len(data.Item) != 2 || data.Item[1].Name != "MATSHITA DVD-R UJ-8A8" {
+		t.Fatalf("Expected both drives, got %+v", data.Item)
+	}
+
+	burner, reader := data.Item[0], data.Item[1]
+	if !burner.CanBurn() || burner.HasMedia() || len(burner.WriteStrategyList()) != 3 {
+		t.Errorf("Unexpected burner: %+v", burner)
+	}
+	if reader.CanBurn() || !reader.HasMedia() {
+		t.Errorf("Unexpected reader: %+v", reader)
+	}
+	if (DataTypeItem{BurnSupport: "not yes"}).CanBurn() {
+		t.Error("Expected free text containing yes not to count as burn support")
+	}
+}
@@ -3,15 +3,90 @@ package extensions
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
-// DataTypeItem represents the structure of SPExtensionsDataType.
+// DataTypeItem represents the structure of SPExtensionsDataType. Each item
+// is a kernel extension.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name               string   `json:"_name"`
+	Architectures      []string `json:"spext_architectures,omitempty"`
+	BundleID           string   `json:"spext_bundleid,omitempty"`
+	HasAllDependencies string   `json:"spext_hasAllDependencies,omitempty"`
+	LastModified       string   `json:"spext_lastModified,omitempty"`
+	Loadable           string   `json:"spext_loadable,omitempty"`
+	Loaded             string   `json:"spext_loaded,omitempty"`
+	Notarized          string   `json:"spext_notarized,omitempty"`
+	ObtainedFrom       string   `json:"spext_obtained_from,omitempty"`
+	Path               string   `json:"spext_path,omitempty"`
+	RuntimeEnvironment string   `json:"spext_runtimeenvironment,omitempty"`
+	SignedBy           string   `json:"spext_signed_by,omitempty"`
+	Version            string   `json:"spext_version,omitempty"`
+}
+
+// IsLoaded reports whether the extension is currently loaded.
+func (d DataTypeItem) IsLoaded() bool {
+	return normalize.Bool(d.Loaded)
+}
+
+// IsNotarized reports whether the extension is notarized.
+func (d DataTypeItem) IsNotarized() bool {
+	return normalize.Bool(d.Notarized)
+}
+
+// Signers returns the signing chain, leaf first, e.g. ["Developer ID
+// Application: Example, Inc. (ABCDE12345)", "Developer ID Certification
+// Authority", "Apple Root CA"]. Commas inside the developer name do not
+// split the leaf certificate.
+func (d DataTypeItem) Signers() []string {
+	var signers []string
+	for _, piece := range strings.Split(d.SignedBy, ", ") {
+		piece = strings.TrimSpace(piece)
+		if piece == "" {
+			continue
+		}
+		if n := len(signers); n > 0 && continuesSigner(signers[n-1], piece) {
+			signers[n-1] += ", " + piece
+			continue
+		}
+		signers = append(signers, piece)
+	}
+	return signers
+}
+
+// authorityPrefixes start the names of the certificates found in macOS
+// signing chains
+var authorityPrefixes = []string{"Apple ", "Developer ID ", "Software Signing", "Mac Developer", "3rd Party Mac Developer"}
+
+// continuesSigner reports whether piece is the rest of a developer name that
+// was split at a comma, e.g. "Inc. (EQHXZ8M8AV)" after "Developer ID
+// Application: Google". Only a leaf naming a developer ("Label: Name") whose
+// team ID is not closed yet can continue.
+func continuesSigner(signer, piece string) bool {
+	if !strings.Contains(signer, ": ") || strings.HasSuffix(signer, ")") {
+		return false
+	}
+	for _, prefix := range authorityPrefixes {
+		if strings.HasPrefix(piece, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// ThirdParty returns the extensions not obtained from Apple.
+func ThirdParty(items []DataTypeItem) []DataTypeItem {
+	var matched []DataTypeItem
+	for _, item := range items {
+		if normalize.Canonical(item.ObtainedFrom) != "apple" {
+			matched = append(matched, item)
+		}
+	}
+	return matched
 }
 
 // DataType holds the parsed system profiler data for SPExtensionsDataType.
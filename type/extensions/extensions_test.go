@@ -69,3 +69,31 @@ func TestExtensionsFields(t *testing.T) {
 		}
 	}
 }
+
+func TestExtensionsSigners(t *testing.T) {
+	jsonData := []byte(`[
+		{"_name": "AppleUSBAudio", "spext_obtained_from": "spext_apple", "spext_loaded": "spext_yes",
+		 "spext_signed_by": "Software Signing, Apple Code Signing Certification Authority, Apple Root CA"},
+		{"_name": "SoftRAID", "spext_obtained_from": "spext_identified_developer", "spext_loaded": "spext_no",
+		 "spext_signed_by": "Developer ID Application: OWC Holdings Inc. (9Z9HVS5U9B), Developer ID Certification Authority, Apple Root CA"},
+		{"_name": "KeystoneAgent", "spext_obtained_from": "spext_identified_developer",
+		 "spext_signed_by": "Developer ID Application: Google, Inc. (EQHXZ8M8AV), Developer ID Certification Authority, Apple Root CA"}
+	]`)
+	var items []DataTypeItem
+	if err := json.Unmarshal(jsonData, &items); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if signers := items[1].Signers(); len(signers) != 3 || signers[0] != "Developer ID Application: OWC Holdings Inc. (9Z9HVS5U9B)" {
+		t.Errorf("Unexpected signers: %q", signers)
+	}
+	signers := items[2].Signers()
+	if len(signers) != 3 || signers[0] != "Developer ID Application: Google, Inc. (EQHXZ8M8AV)" || signers[1] != "Developer ID Certification Authority" {
+		t.Errorf("Expected the comma in the developer name to be kept, got %q", signers)
+	}
+	if !items[0].IsLoaded() || items[1].IsLoaded() {
+		t.Error("Expected only AppleUSBAudio to be loaded")
+	}
+	if third := ThirdParty(items); len(third) != 2 || third[0].Name != "SoftRAID" {
+		t.Errorf("Expected SoftRAID and KeystoneAgent as third party extensions, got %+v", third)
+	}
+}
@@ -3,15 +3,60 @@ package fibrechannel
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/samburba/go-system-profiler/v2/disk"
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// Device represents a drive reachable through a Fibre Channel port.
+type Device struct {
+	disk.Device
+	LUN      string `json:"spfibrechannel_lun,omitempty"`
+	NodeWWN  string `json:"spfibrechannel_node_wwn,omitempty"`
+	PortWWN  string `json:"spfibrechannel_port_wwn,omitempty"`
+	TargetID string `json:"spfibrechannel_target_id,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPFibreChannelDataType.
+// Each item is a Fibre Channel port and the drives reachable through it.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name       string   `json:"_name"`
+	Items      []Device `json:"_items,omitempty"`
+	LinkSpeed  string   `json:"spfibrechannel_link_speed,omitempty"`
+	LinkStatus string   `json:"spfibrechannel_link_status,omitempty"`
+	NodeWWN    string   `json:"spfibrechannel_node_wwn,omitempty"`
+	PortID     string   `json:"spfibrechannel_port_id,omitempty"`
+	PortWWN    string   `json:"spfibrechannel_port_wwn,omitempty"`
+	Product    string   `json:"spfibrechannel_product,omitempty"`
+	Topology   string   `json:"spfibrechannel_topology,omitempty"`
+	Vendor     string   `json:"spfibrechannel_vendor,omitempty"`
+}
+
+// IsLinkUp reports whether the port link is established.
+func (d DataTypeItem) IsLinkUp() bool {
+	return strings.EqualFold(strings.TrimSpace(d.LinkStatus), "Established") || normalize.Bool(d.LinkStatus)
+}
+
+// Speed returns the port link speed, e.g. 8 Gb/s for "8 Gigabit".
+func (d DataTypeItem) Speed() (normalize.Speed, error) {
+	return normalize.ParseSpeed(d.LinkSpeed)
+}
+
+// NormalizeWWN formats a world wide name as lowercase colon separated
+// octets, e.g. "21000024FF3D6A1B" becomes "21:00:00:24:ff:3d:6a:1b".
+func NormalizeWWN(wwn string) string {
+	hex := strings.ToLower(strings.NewReplacer(":", "", "-", "", " ", "").Replace(wwn))
+	if len(hex)%2 != 0 {
+		return hex
+	}
+	octets := make([]string, 0, len(hex)/2)
+	for i := 0; i < len(hex); i += 2 {
+		octets = append(octets, hex[i:i+2])
+	}
+	return strings.Join(octets, ":")
 }
 
 // DataType holds the parsed system profiler data for SPFibreChannelDataType.
@@ -25,7 +70,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPFibreChannelDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPFibreChannelDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize fibrechannel data: %w", err)
 			return
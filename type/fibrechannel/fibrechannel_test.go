@@ -0,0 +1,69 @@
+package fibrechannel
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFibreChannelDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No Fibre Channel data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestFibreChannelUnmarshal(t *testing.T) {
+	input := `{
+		"_name": "Fibre Channel Domain 0",
+		"_items": [
+			{
+				"_name": "Promise VTrak",
+				"bsd_name": "disk3",
+				"smart_status": "Not Supported",
+				"spfibrechannel_lun": "0",
+				"spfibrechannel_port_wwn": "26:00:00:01:55:60:1A:2B",
+				"spfibrechannel_target_id": "1"
+			}
+		],
+		"spfibrechannel_link_speed": "8 Gigabit",
+		"spfibrechannel_link_status": "Established",
+		"spfibrechannel_port_wwn": "21000024FF3D6A1B"
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal Fibre Channel port: %v", err)
+	}
+
+	if !item.IsLinkUp() {
+		t.Error("Expected the link to be up")
+	}
+	if speed, err := item.Speed(); err != nil || speed.Gbps() != 8 {
+		t.Errorf("Expected an 8 Gb/s link, got %v (%v)", speed, err)
+	}
+	if wwn := NormalizeWWN(item.PortWWN); wwn != "21:00:00:24:ff:3d:6a:1b" {
+		t.Errorf("Unexpected port WWN: %s", wwn)
+	}
+	if len(item.Items) != 1 || item.Items[0].TargetID != "1" || item.Items[0].SMARTVerified() {
+		t.Errorf("Unexpected devices: %+v", item.Items)
+	}
+	if wwn := NormalizeWWN(item.Items[0].PortWWN); wwn != "26:00:00:01:55:60:1a:2b" {
+		t.Errorf("Unexpected target WWN: %s", wwn)
+	}
+}
@@ -3,9 +3,12 @@ package firewall
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPFirewallDataType.
@@ -17,6 +20,151 @@ type DataTypeItem struct {
 	SpfirewallStealthEnabled string            `json:"spfirewall_stealthenabled,omitempty"`
 }
 
+// GlobalState is the application firewall mode. States are ordered from
+// least to most restrictive.
+type GlobalState int
+
+const (
+	GlobalStateUnknown GlobalState = iota
+	GlobalStateOff
+	GlobalStateAllowSpecific
+	GlobalStateBlockAll
+)
+
+// String returns the name of the global state.
+func (s GlobalState) String() string {
+	switch s {
+	case GlobalStateOff:
+		return "Off"
+	case GlobalStateAllowSpecific:
+		return "AllowSpecific"
+	case GlobalStateBlockAll:
+		return "BlockAll"
+	}
+	return "Unknown"
+}
+
+// Action is the decision a rule applies to incoming connections.
+type Action int
+
+const (
+	ActionUnknown Action = iota
+	ActionAllow
+	ActionBlock
+)
+
+// String returns the name of the action.
+func (a Action) String() string {
+	switch a {
+	case ActionAllow:
+		return "Allow"
+	case ActionBlock:
+		return "Block"
+	}
+	return "Unknown"
+}
+
+// Rule represents the firewall decision for one application.
+type Rule struct {
+	Path   string `json:"path"`
+	Action Action `json:"action"`
+}
+
+// Policy describes a desired firewall configuration. Zero values are not checked.
+type Policy struct {
+	MinimumState   GlobalState       `json:"minimum_state,omitempty"`
+	StealthEnabled *bool             `json:"stealth_enabled,omitempty"`
+	LoggingEnabled *bool             `json:"logging_enabled,omitempty"`
+	Rules          map[string]Action `json:"rules,omitempty"`
+}
+
+// Deviation describes a difference between the firewall and a Policy.
+type Deviation struct {
+	Field    string `json:"field"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// GlobalState returns the parsed firewall mode.
+func (d DataTypeItem) GlobalState() GlobalState {
+	value := normalize.Canonical(d.SpfirewallGlobalState)
+	switch {
+	case strings.HasSuffix(value, "block_all"), value == "blockall":
+		return GlobalStateBlockAll
+	case strings.HasSuffix(value, "limit_connections"), strings.HasSuffix(value, "allow_specific"), value == normalize.On:
+		return GlobalStateAllowSpecific
+	case value == normalize.Off:
+		return GlobalStateOff
+	}
+	return GlobalStateUnknown
+}
+
+// Enabled reports whether the firewall filters incoming connections.
+func (d DataTypeItem) Enabled() bool {
+	return d.GlobalState() > GlobalStateOff
+}
+
+// StealthEnabled reports whether stealth mode is on.
+func (d DataTypeItem) StealthEnabled() bool {
+	return normalize.Bool(d.SpfirewallStealthEnabled)
+}
+
+// LoggingEnabled reports whether firewall logging is on.
+func (d DataTypeItem) LoggingEnabled() bool {
+	return normalize.Bool(d.SpfirewallLoggingEnabled)
+}
+
+// Rules returns the per-application rules sorted by path.
+func (d DataTypeItem) Rules() []Rule {
+	rules := make([]Rule, 0, len(d.SpfirewallApplications))
+	for path, value := range d.SpfirewallApplications {
+		rules = append(rules, Rule{Path: path, Action: parseAction(value)})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Path < rules[j].Path })
+	return rules
+}
+
+// Compare returns every way the firewall deviates from policy.
+func (d DataTypeItem) Compare(policy Policy) []Deviation {
+	var deviations []Deviation
+	if policy.MinimumState != GlobalStateUnknown && d.GlobalState() < policy.MinimumState {
+		deviations = append(deviations, Deviation{"global_state", "at least " + policy.MinimumState.String(), d.GlobalState().String()})
+	}
+	if policy.StealthEnabled != nil && d.StealthEnabled() != *policy.StealthEnabled {
+		deviations = append(deviations, Deviation{"stealth_enabled", fmt.Sprint(*policy.StealthEnabled), fmt.Sprint(d.StealthEnabled())})
+	}
+	if policy.LoggingEnabled != nil && d.LoggingEnabled() != *policy.LoggingEnabled {
+		deviations = append(deviations, Deviation{"logging_enabled", fmt.Sprint(*policy.LoggingEnabled), fmt.Sprint(d.LoggingEnabled())})
+	}
+	actual := make(map[string]Action, len(d.SpfirewallApplications))
+	for _, rule := range d.Rules() {
+		actual[rule.Path] = rule.Action
+	}
+	paths := make([]string, 0, len(policy.Rules))
+	for path := range policy.Rules {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if got, want := actual[path], policy.Rules[path]; got != want {
+			deviations = append(deviations, Deviation{"rule " + path, want.String(), got.String()})
+		}
+	}
+	return deviations
+}
+
+// parseAction interprets values such as "spfirewall_allow_all" or "Block incoming connections".
+func parseAction(value string) Action {
+	value = strings.ToLower(value)
+	switch {
+	case strings.Contains(value, "block"), strings.Contains(value, "deny"):
+		return ActionBlock
+	case strings.Contains(value, "allow"):
+		return ActionAllow
+	}
+	return ActionUnknown
+}
+
 // DataType holds the parsed system profiler data for SPFirewallDataType.
 var DataType *common.DataType[DataTypeItem]
 
@@ -73,3 +73,70 @@ func TestFirewallFields(t *testing.T) {
 		}
 	}
 }
+
+func TestFirewallPolicy(t *testing.T) {
+	input := `{
+		"_name": "Firewall Settings",
+		"spfirewall_applications": {
+			"/Applications/Zoom.app": "spfirewall_allow_all",
+			"/usr/libexec/sshd-keygen-wrapper": "spfirewall_block_all"
+		},
+		"spfirewall_globalstate": "spfirewall_globalstate_limit_connections",
+		"spfirewall_loggingenabled": "Yes",
+		"spfirewall_stealthenabled": "No"
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal firewall data: %v", err)
+	}
+
+	if item.GlobalState() != GlobalStateAllowSpecific || !item.Enabled() {
+		t.Errorf("Expected AllowSpecific, got %s", item.GlobalState())
+	}
+	if !item.LoggingEnabled() || item.StealthEnabled() {
+		t.Errorf("Unexpected flags: logging=%v stealth=%v", item.LoggingEnabled(), item.StealthEnabled())
+	}
+
+	rules := item.Rules()
+	if len(rules) != 2 || rules[0].Path != "/Applications/Zoom.app" || rules[0].Action != ActionAllow || rules[1].Action != ActionBlock {
+		t.Errorf("Unexpected rules: %+v", rules)
+	}
+
+	// A compliant policy produces no deviations
+	logging := true
+	if deviations := item.Compare(Policy{MinimumState: GlobalStateAllowSpecific, LoggingEnabled: &logging}); len(deviations) != 0 {
+		t.Errorf("Expected no deviations, got %+v", deviations)
+	}
+
+	stealth := true
+	deviations := item.Compare(Policy{
+		MinimumState:   GlobalStateBlockAll,
+		StealthEnabled: &stealth,
+		Rules:          map[string]Action{"/Applications/Zoom.app": ActionBlock, "/Applications/Slack.app": ActionAllow},
+	})
+	if len(deviations) != 4 {
+		t.Fatalf("Expected 4 deviations, got %+v", deviations)
+	}
+	if deviations[0].Field != "global_state" || deviations[1].Field != "stealth_enabled" {
+		t.Errorf("Unexpected deviation order: %+v", deviations)
+	}
+	if deviations[2].Field != "rule /Applications/Slack.app" || deviations[2].Actual != "Unknown" {
+		t.Errorf("Expected a missing Slack rule, got %+v", deviations[2])
+	}
+}
+
+func TestFirewallGlobalStates(t *testing.T) {
+	cases := map[string]GlobalState{
+		"spfirewall_globalstate_off":               GlobalStateOff,
+		"spfirewall_globalstate_limit_connections": GlobalStateAllowSpecific,
+		"spfirewall_globalstate_block_all":         GlobalStateBlockAll,
+		"Désactivé":                                GlobalStateOff,
+		"":                                         GlobalStateUnknown,
+	}
+	for value, want := range cases {
+		if got := (DataTypeItem{SpfirewallGlobalState: value}).GlobalState(); got != want {
+			t.Errorf("GlobalState(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
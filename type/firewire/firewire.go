@@ -3,15 +3,66 @@ package firewire
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// Device represents a FireWire device and the devices daisy-chained behind it.
+type Device struct {
+	Name         string   `json:"_name"`
+	Items        []Device `json:"_items,omitempty"`
+	GUID         string   `json:"device_guid,omitempty"`
+	Manufacturer string   `json:"device_manufacturer,omitempty"`
+	Model        string   `json:"device_model,omitempty"`
+	Speed        string   `json:"device_speed,omitempty"`
+	Port         string   `json:"connected_port,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPFireWireDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name           string   `json:"_name"`
+	Items          []Device `json:"_items,omitempty"`
+	MaxDeviceSpeed string   `json:"max_device_speed,omitempty"`
+}
+
+// LinkSpeed returns the device link speed, e.g. 800 Mb/s for "up_to_800_mb_sec".
+func (d Device) LinkSpeed() (normalize.Speed, error) {
+	return parseSpeed(d.Speed)
+}
+
+// MaxSpeed returns the bus maximum speed.
+func (d DataTypeItem) MaxSpeed() (normalize.Speed, error) {
+	return parseSpeed(d.MaxDeviceSpeed)
+}
+
+// Devices returns every device in the tree in depth-first order.
+func (d DataTypeItem) Devices() []Device {
+	var devices []Device
+	var walk func([]Device)
+	walk = func(items []Device) {
+		for _, device := range items {
+			devices = append(devices, device)
+			walk(device.Items)
+		}
+	}
+	walk(d.Items)
+	return devices
+}
+
+// parseSpeed parses values such as "up_to_800_mb_sec" or "800 Mb/s", and the
+// IEEE 1394 notation "S400" for 400 Mb/s.
+func parseSpeed(value string) (normalize.Speed, error) {
+	value = strings.TrimSpace(value)
+	if len(value) > 1 && (value[0] == 'S' || value[0] == 's') {
+		if _, err := strconv.Atoi(value[1:]); err == nil {
+			value = value[1:] + " Mb/s"
+		}
+	}
+	return normalize.ParseSpeed(value)
 }
 
 // DataType holds the parsed system profiler data for SPFireWireDataType.
@@ -25,7 +76,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPFireWireDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPFireWireDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize firewire data: %w", err)
 			return
@@ -0,0 +1,71 @@
+package firewire
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/normalize"
+)
+
+func TestFireWireDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No FireWire data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestFireWireDeviceTree(t *testing.T) {
+	input := `{
+		"_name": "FireWire Bus",
+		"max_device_speed": "up_to_800_mb_sec",
+		"_items": [
+			{
+				"_name": "LaCie d2",
+				"device_manufacturer": "LaCie",
+				"device_speed": "up_to_800_mb_sec",
+				"_items": [
+					{"_name": "Audio Interface", "device_speed": "up_to_400_mb_sec"}
+				]
+			}
+		]
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal FireWire data: %v", err)
+	}
+
+	if speed, err := item.MaxSpeed(); err != nil || speed != 800*normalize.MbitPerSecond {
+		t.Errorf("Expected 800 Mb/s bus, got %v (%v)", speed, err)
+	}
+
+	devices := item.Devices()
+	if len(devices) != 2 || devices[1].Name != "Audio Interface" {
+		t.Fatalf("Unexpected device tree: %+v", devices)
+	}
+	if speed, err := devices[1].LinkSpeed(); err != nil || speed.Mbps() != 400 {
+		t.Errorf("Expected 400 Mb/s device, got %v (%v)", speed, err)
+	}
+	if speed, err := (Device{Speed: "S3200"}).LinkSpeed(); err != nil || speed.Mbps() != 3200 {
+		t.Errorf("Expected 3200 Mb/s for S3200, got %v (%v)", speed, err)
+	}
+	if _, err := (Device{Speed: "unknown"}).LinkSpeed(); err == nil {
+		t.Error("Expected an error for an unknown speed")
+	}
+}
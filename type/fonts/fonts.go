@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // Typeface represents a font typeface.
@@ -34,6 +35,26 @@ type DataTypeItem struct {
 	Typefaces []Typeface `json:"typefaces,omitempty"`
 }
 
+// IsEnabled reports whether the typeface is enabled, independent of the system language.
+func (t Typeface) IsEnabled() bool {
+	return normalize.Bool(t.Enabled)
+}
+
+// IsValid reports whether the typeface passed validation.
+func (t Typeface) IsValid() bool {
+	return normalize.Bool(t.Valid)
+}
+
+// IsDuplicate reports whether the typeface is a duplicate of another installed typeface.
+func (t Typeface) IsDuplicate() bool {
+	return normalize.Bool(t.Duplicate)
+}
+
+// IsEnabled reports whether the font file is enabled, independent of the system language.
+func (d DataTypeItem) IsEnabled() bool {
+	return normalize.Bool(d.Enabled)
+}
+
 // DataType holds the parsed system profiler data for SPFontsDataType.
 var DataType *common.DataType[DataTypeItem]
 
@@ -6,12 +6,55 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPFrameworksDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name             string             `json:"_name"`
+	ArchKind         normalize.ArchKind `json:"arch_kind,omitempty"`
+	Info             string             `json:"info,omitempty"`
+	LastModified     string             `json:"lastModified,omitempty"`
+	ObtainedFrom     string             `json:"obtained_from,omitempty"`
+	Path             string             `json:"path,omitempty"`
+	PrivateFramework string             `json:"private_framework,omitempty"`
+	SignedBy         []string           `json:"signed_by,omitempty"`
+	Version          string             `json:"version,omitempty"`
+}
+
+// IsPrivate reports whether the framework is a private framework.
+func (d DataTypeItem) IsPrivate() bool {
+	return normalize.Bool(d.PrivateFramework)
+}
+
+// Signer returns the leaf signing authority, or an empty string for unsigned frameworks.
+func (d DataTypeItem) Signer() string {
+	if len(d.SignedBy) == 0 {
+		return ""
+	}
+	return d.SignedBy[0]
+}
+
+// IntelOnly returns the frameworks that contain no Apple Silicon code.
+func IntelOnly(items []DataTypeItem) []DataTypeItem {
+	var matched []DataTypeItem
+	for _, item := range items {
+		if item.ArchKind.IsIntelOnly() {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+// ThirtyTwoBit returns the frameworks that only contain 32-bit code.
+func ThirtyTwoBit(items []DataTypeItem) []DataTypeItem {
+	var matched []DataTypeItem
+	for _, item := range items {
+		if item.ArchKind.Is32Bit() {
+			matched = append(matched, item)
+		}
+	}
+	return matched
 }
 
 // DataType holds the parsed system profiler data for SPFrameworksDataType.
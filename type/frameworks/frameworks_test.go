@@ -69,3 +69,33 @@ func TestFrameworksFields(t *testing.T) {
 		}
 	}
 }
+
+func TestFrameworksArchitecture(t *testing.T) {
+	input := `[
+		{"_name": "AppKit", "arch_kind": "arch_arm_i64", "obtained_from": "apple", "path": "/System/Library/Frameworks/AppKit.framework", "private_framework": "no", "signed_by": ["Software Signing", "Apple Code Signing Certification Authority", "Apple Root CA"], "version": "6.9"},
+		{"_name": "LegacyPlugin", "arch_kind": "arch_i64", "obtained_from": "identified_developer", "path": "/Library/Frameworks/LegacyPlugin.framework", "private_framework": "no"},
+		{"_name": "AncientKit", "arch_kind": "arch_i32", "path": "/Library/Frameworks/AncientKit.framework", "private_framework": "yes"}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal frameworks: %v", err)
+	}
+
+	if signer := items[0].Signer(); signer != "Software Signing" {
+		t.Errorf("Unexpected signer: %q", signer)
+	}
+	if items[0].IsPrivate() || !items[2].IsPrivate() {
+		t.Error("Unexpected private framework flags")
+	}
+
+	intel := IntelOnly(items)
+	if len(intel) != 2 || intel[0].Name != "LegacyPlugin" || intel[1].Name != "AncientKit" {
+		t.Errorf("Unexpected Intel-only frameworks: %+v", intel)
+	}
+
+	legacy := ThirtyTwoBit(items)
+	if len(legacy) != 1 || legacy[0].Name != "AncientKit" {
+		t.Errorf("Unexpected 32-bit frameworks: %+v", legacy)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPHardwareDataType.
@@ -14,6 +15,8 @@ type DataTypeItem struct {
 	ActivationLockStatus string `json:"activation_lock_status,omitempty"`
 	BootRomVersion       string `json:"boot_rom_version,omitempty"`
 	ChipType             string `json:"chip_type,omitempty"`
+	CPUType              string `json:"cpu_type,omitempty"`
+	ProcessorSpeed       string `json:"current_processor_speed,omitempty"`
 	MachineModel         string `json:"machine_model,omitempty"`
 	MachineName          string `json:"machine_name,omitempty"`
 	ModelNumber          string `json:"model_number,omitempty"`
@@ -25,6 +28,22 @@ type DataTypeItem struct {
 	SerialNumber         string `json:"serial_number,omitempty"`
 }
 
+// PhysicalMemoryBytes returns the installed memory, e.g. 16 GiB for "16 GB".
+func (d DataTypeItem) PhysicalMemoryBytes() (normalize.ByteSize, error) {
+	return normalize.ParseMemorySize(d.PhysicalMemory)
+}
+
+// ProcessorFrequency returns the processor clock speed of Intel Macs, e.g.
+// 2.3 GHz. Apple silicon Macs do not report one.
+func (d DataTypeItem) ProcessorFrequency() (normalize.Frequency, error) {
+	return normalize.ParseFrequency(d.ProcessorSpeed)
+}
+
+// ActivationLockEnabled reports whether Activation Lock is enabled.
+func (d DataTypeItem) ActivationLockEnabled() bool {
+	return normalize.Bool(d.ActivationLockStatus)
+}
+
 // DataType holds the parsed system profiler data for SPHardwareDataType.
 var DataType common.ObjectDataType[DataTypeItem]
 
@@ -3,6 +3,9 @@ package hardware
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 func TestHardwareDataType(t *testing.T) {
@@ -74,3 +77,28 @@ func TestHardwareFields(t *testing.T) {
 		t.Logf("Physical memory: %v", physicalMemory)
 	}
 }
+
+func TestHardwareDecode(t *testing.T) {
+	var data map[string]interface{}
+	input := `{"_name": "hardware_overview", "activation_lock_status": "activation_lock_disabled", "current_processor_speed": "2,3 GHz", "physical_memory": "16 GB", "serial_number": "C02XXXXXXXXX"}`
+	if err := json.Unmarshal([]byte(input), &data); err != nil {
+		t.Fatalf("Failed to unmarshal hardware data: %v", err)
+	}
+
+	item, err := common.ObjectDataType[DataTypeItem](data).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode hardware data: %v", err)
+	}
+	if item.SerialNumber != "C02XXXXXXXXX" {
+		t.Errorf("Unexpected serial number %q", item.SerialNumber)
+	}
+	if memory, err := item.PhysicalMemoryBytes(); err != nil || memory != 16*normalize.GiB {
+		t.Errorf("Expected 16 GiB, got %v (%v)", memory, err)
+	}
+	if speed, err := item.ProcessorFrequency(); err != nil || speed != 2.3*normalize.Gigahertz {
+		t.Errorf("Expected 2.3 GHz, got %v (%v)", speed, err)
+	}
+	if item.ActivationLockEnabled() {
+		t.Error("Expected Activation Lock to be disabled")
+	}
+}
@@ -3,15 +3,76 @@ package ibridge
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// SecureBootLevel is the Secure Boot policy enforced by the T2 chip.
+type SecureBootLevel int
+
+const (
+	SecureBootUnknown SecureBootLevel = iota
+	SecureBootNone
+	SecureBootMedium
+	SecureBootFull
+)
+
+// String returns the name of the Secure Boot level.
+func (l SecureBootLevel) String() string {
+	switch l {
+	case SecureBootNone:
+		return "No Security"
+	case SecureBootMedium:
+		return "Medium Security"
+	case SecureBootFull:
+		return "Full Security"
+	}
+	return "Unknown"
+}
+
 // DataTypeItem represents the structure of SPiBridgeDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name               string `json:"_name"`
+	BootUUID           string `json:"ibridge_boot_uuid,omitempty"`
+	Build              string `json:"ibridge_build,omitempty"`
+	ExternalBootPolicy string `json:"ibridge_extra_boot_policies,omitempty"`
+	ModelIdentifier    string `json:"ibridge_model_identifier_top,omitempty"`
+	ModelName          string `json:"ibridge_model_name,omitempty"`
+	SecureBoot         string `json:"ibridge_secure_boot,omitempty"`
+}
+
+// SecureBootLevel returns the parsed Secure Boot level. Values missing from
+// the normalize token dictionary, such as other languages, are unknown.
+func (d DataTypeItem) SecureBootLevel() SecureBootLevel {
+	switch normalize.Canonical(d.SecureBoot) {
+	case normalize.FullSecurity:
+		return SecureBootFull
+	case normalize.MediumSecurity:
+		return SecureBootMedium
+	case normalize.NoSecurity, normalize.Off, normalize.Disabled:
+		return SecureBootNone
+	}
+	return SecureBootUnknown
+}
+
+// MeetsSecureBoot reports whether the Secure Boot level is at least minimum.
+func (d DataTypeItem) MeetsSecureBoot(minimum SecureBootLevel) bool {
+	level := d.SecureBootLevel()
+	return level != SecureBootUnknown && level >= minimum
+}
+
+// AllowsExternalBoot reports whether booting from external media is allowed.
+// An unrecognized policy is reported as not allowed.
+func (d DataTypeItem) AllowsExternalBoot() bool {
+	return normalize.Bool(d.ExternalBootPolicy)
+}
+
+// IsT2 reports whether the controller is an Apple T2 Security Chip.
+func (d DataTypeItem) IsT2() bool {
+	return strings.Contains(d.ModelName, "T2") || strings.HasPrefix(d.ModelIdentifier, "iBridge2")
 }
 
 // DataType holds the parsed system profiler data for SPiBridgeDataType.
@@ -25,7 +86,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPiBridgeDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPiBridgeDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize ibridge data: %w", err)
 			return
@@ -3,6 +3,8 @@ package ibridge
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
 func TestIBridgeDataType(t *testing.T) {
@@ -71,3 +73,65 @@ func TestIBridgeFields(t *testing.T) {
 		t.Logf("iBridge device %d: %s", i, item.Name)
 	}
 }
+
+func TestIBridgeSecureBoot(t *testing.T) {
+	input := `{
+		"_name": "Controller Information",
+		"ibridge_boot_uuid": "5F3B6A12-0000-0000-0000-000000000000",
+		"ibridge_build": "21P5077",
+		"ibridge_extra_boot_policies": "Disallow booting from external media",
+		"ibridge_model_identifier_top": "iBridge2,5",
+		"ibridge_model_name": "Apple T2 Security Chip",
+		"ibridge_secure_boot": "Medium Security"
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal iBridge data: %v", err)
+	}
+
+	if !item.IsT2() {
+		t.Error("Expected a T2 controller")
+	}
+	if level := item.SecureBootLevel(); level != SecureBootMedium {
+		t.Errorf("Expected medium security, got %s", level)
+	}
+	if !item.MeetsSecureBoot(SecureBootMedium) || item.MeetsSecureBoot(SecureBootFull) {
+		t.Error("Unexpected Secure Boot comparison result")
+	}
+	if item.AllowsExternalBoot() {
+		t.Error("Expected external boot to be disallowed")
+	}
+}
+
+func TestIBridgeParse(t *testing.T) {
+	output := `{"SPiBridgeDataType": [{
+		"_name": "Controller Information",
+		"ibridge_build": "21P5077",
+		"ibridge_extra_boot_policies": "Allow booting from external media",
+		"ibridge_model_identifier_top": "iBridge2,5",
+		"ibridge_model_name": "Apple T2 Security Chip",
+		"ibridge_secure_boot": "Full Security"
+	}]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPiBridgeDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse iBridge data: %v", err)
+	}
+	if len(data.Item) != 1 {
+		t.Fatalf("Expected the controller entry, got %+v", data.Item)
+	}
+
+	controller := data.Item[0]
+	if !controller.IsT2() || controller.SecureBootLevel() != SecureBootFull || !controller.MeetsSecureBoot(SecureBootFull) {
+		t.Errorf("Unexpected controller: %+v", controller)
+	}
+	if !controller.AllowsExternalBoot() {
+		t.Error("Expected external boot to be allowed")
+	}
+	for _, value := range []string{"Fullness unknown", "Medium Security?", ""} {
+		if level := (DataTypeItem{SecureBoot: value}).SecureBootLevel(); level != SecureBootUnknown {
+			t.Errorf("SecureBootLevel(%q) = %s, want Unknown", value, level)
+		}
+	}
+}
@@ -4,14 +4,51 @@ package installhistory
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
-// DataTypeItem represents the structure of SPInstallHistoryDataType.
+// DataTypeItem represents the structure of SPInstallHistoryDataType. Each
+// item is one installation of a package or update.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name           string `json:"_name"`
+	InstallDate    string `json:"install_date,omitempty"`
+	InstallVersion string `json:"install_version,omitempty"`
+	PackageSource  string `json:"package_source,omitempty"`
+}
+
+// InstallTime returns the time the package was installed.
+func (d DataTypeItem) InstallTime() (time.Time, error) {
+	return common.ParseTimestamp(d.InstallDate)
+}
+
+// FromApple reports whether the package was provided by Apple, as opposed
+// to a third party.
+func (d DataTypeItem) FromApple() bool {
+	return normalize.Canonical(d.PackageSource) == "apple"
+}
+
+// Latest returns the most recent installation of every package name, in the
+// order the names first appear.
+func Latest(items []DataTypeItem) []DataTypeItem {
+	index := map[string]int{}
+	var latest []DataTypeItem
+	for _, item := range items {
+		i, ok := index[item.Name]
+		if !ok {
+			index[item.Name] = len(latest)
+			latest = append(latest, item)
+			continue
+		}
+		current, err := latest[i].InstallTime()
+		installed, err2 := item.InstallTime()
+		if err != nil || (err2 == nil && installed.After(current)) {
+			latest[i] = item
+		}
+	}
+	return latest
 }
 
 // DataType holds the parsed system profiler data for SPInstallHistoryDataType.
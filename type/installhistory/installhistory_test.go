@@ -71,3 +71,25 @@ func TestInstallHistoryFields(t *testing.T) {
 		t.Logf("Install history item %d: %s", i, item.Name)
 	}
 }
+
+func TestInstallHistoryLatest(t *testing.T) {
+	jsonData := []byte(`[
+		{"_name": "XProtectPlistConfigData", "install_date": "2024-05-14T10:00:00Z", "install_version": "2193", "package_source": "package_source_apple"},
+		{"_name": "Zoom", "install_date": "2024-03-01T09:00:00Z", "install_version": "5.17.11", "package_source": "package_source_other"},
+		{"_name": "XProtectPlistConfigData", "install_date": "2024-04-02T10:00:00Z", "install_version": "2192", "package_source": "package_source_apple"}
+	]`)
+	var items []DataTypeItem
+	if err := json.Unmarshal(jsonData, &items); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	latest := Latest(items)
+	if len(latest) != 2 || latest[0].InstallVersion != "2193" || latest[1].Name != "Zoom" {
+		t.Errorf("Unexpected latest installs: %+v", latest)
+	}
+	if !latest[0].FromApple() || latest[1].FromApple() {
+		t.Error("Expected only XProtect to come from Apple")
+	}
+	if installed, err := latest[1].InstallTime(); err != nil || installed.Month() != 3 {
+		t.Errorf("InstallTime() = %v (%v)", installed, err)
+	}
+}
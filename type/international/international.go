@@ -3,15 +3,163 @@ package international
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPInternationalDataType.
+// system_profiler reports system-wide and per-user settings as separate
+// items; use Combined to merge them.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name                            string   `json:"_name"`
+	BootLocale                      string   `json:"boot_locale,omitempty"`
+	LinguisticDataAssetsRequested   []string `json:"linguistic_data_assets_requested,omitempty"`
+	SystemCountry                   string   `json:"system_country,omitempty"`
+	SystemInterfaceLanguages        []string `json:"system_interface_languages,omitempty"`
+	SystemLanguages                 []string `json:"system_languages,omitempty"`
+	SystemTextDirection             string   `json:"system_text_direction,omitempty"`
+	UserAssumedNumSystem            string   `json:"user_assumed_num_system,omitempty"`
+	UserCalendar                    string   `json:"user_calendar,omitempty"`
+	UserCountryCode                 string   `json:"user_country_code,omitempty"`
+	UserCurrentInputSource          string   `json:"user_current_input_source,omitempty"`
+	UserEnabledInputSources         []string `json:"user_enabled_input_sources,omitempty"`
+	UserLocale                      string   `json:"user_locale,omitempty"`
+	UserMeasurementUnits            string   `json:"user_measurement_units,omitempty"`
+	UserPreferredInterfaceLanguages []string `json:"user_preferred_interface_languages,omitempty"`
+	UserRegion                      string   `json:"user_region,omitempty"`
+	UserTemperatureUnit             string   `json:"user_temperature_unit,omitempty"`
+	UserTimeZone                    string   `json:"user_time_zone,omitempty"`
+	UserUsesMetricSystem            string   `json:"user_uses_metric_system,omitempty"`
+}
+
+// Combined merges the system and user items into a single item. The first
+// non-empty value wins for every field.
+func Combined(items []DataTypeItem) DataTypeItem {
+	var c DataTypeItem
+	for _, item := range items {
+		first(&c.Name, item.Name)
+		first(&c.BootLocale, item.BootLocale)
+		firstList(&c.LinguisticDataAssetsRequested, item.LinguisticDataAssetsRequested)
+		first(&c.SystemCountry, item.SystemCountry)
+		firstList(&c.SystemInterfaceLanguages, item.SystemInterfaceLanguages)
+		firstList(&c.SystemLanguages, item.SystemLanguages)
+		first(&c.SystemTextDirection, item.SystemTextDirection)
+		first(&c.UserAssumedNumSystem, item.UserAssumedNumSystem)
+		first(&c.UserCalendar, item.UserCalendar)
+		first(&c.UserCountryCode, item.UserCountryCode)
+		first(&c.UserCurrentInputSource, item.UserCurrentInputSource)
+		firstList(&c.UserEnabledInputSources, item.UserEnabledInputSources)
+		first(&c.UserLocale, item.UserLocale)
+		first(&c.UserMeasurementUnits, item.UserMeasurementUnits)
+		firstList(&c.UserPreferredInterfaceLanguages, item.UserPreferredInterfaceLanguages)
+		first(&c.UserRegion, item.UserRegion)
+		first(&c.UserTemperatureUnit, item.UserTemperatureUnit)
+		first(&c.UserTimeZone, item.UserTimeZone)
+		first(&c.UserUsesMetricSystem, item.UserUsesMetricSystem)
+	}
+	return c
+}
+
+// first sets dst to value unless dst is already set.
+func first(dst *string, value string) {
+	if *dst == "" {
+		*dst = value
+	}
+}
+
+// firstList sets dst to values unless dst is already set.
+func firstList(dst *[]string, values []string) {
+	if len(*dst) == 0 {
+		*dst = values
+	}
+}
+
+// SystemLocaleTag returns the boot locale as a BCP-47 language tag.
+func (d DataTypeItem) SystemLocaleTag() string {
+	return NormalizeLanguageTag(d.BootLocale)
+}
+
+// UserLocaleTag returns the user locale as a BCP-47 language tag.
+func (d DataTypeItem) UserLocaleTag() string {
+	return NormalizeLanguageTag(d.UserLocale)
+}
+
+// PreferredLanguageTags returns the user's preferred languages as BCP-47
+// language tags, falling back to the system languages.
+func (d DataTypeItem) PreferredLanguageTags() []string {
+	languages := d.UserPreferredInterfaceLanguages
+	if len(languages) == 0 {
+		languages = d.SystemLanguages
+	}
+	tags := make([]string, 0, len(languages))
+	for _, language := range languages {
+		if tag := NormalizeLanguageTag(language); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// UsesMetric reports whether the user's measurement units are metric. ok is
+// false when the item reports neither the metric system setting nor a known
+// measurement unit.
+func (d DataTypeItem) UsesMetric() (metric bool, ok bool) {
+	if b, err := normalize.ParseBool(d.UserUsesMetricSystem); err == nil {
+		return b, true
+	}
+	switch strings.ToLower(strings.TrimSpace(d.UserMeasurementUnits)) {
+	case "centimeters", "centimetres":
+		return true, true
+	case "inches":
+		return false, true
+	}
+	return false, false
+}
+
+// HasLocale reports whether the user locale matches tag after normalization.
+func (d DataTypeItem) HasLocale(tag string) bool {
+	return strings.EqualFold(d.UserLocaleTag(), NormalizeLanguageTag(tag))
+}
+
+// NormalizeLanguageTag converts Apple locale identifiers such as "en_US",
+// "zh-Hans_CN" or "de_DE@calendar=gregorian" to BCP-47 tags ("en-US",
+// "zh-Hans-CN", "de-DE"). Language subtags are lowercased, scripts
+// title-cased and regions uppercased.
+func NormalizeLanguageTag(identifier string) string {
+	identifier = strings.TrimSpace(identifier)
+	if i := strings.IndexByte(identifier, '@'); i >= 0 {
+		identifier = identifier[:i]
+	}
+	if identifier == "" {
+		return ""
+	}
+	subtags := strings.FieldsFunc(identifier, func(r rune) bool { return r == '_' || r == '-' })
+	for i, subtag := range subtags {
+		switch {
+		case i == 0:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 4 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case (len(subtag) == 2 && isAlpha(subtag)) || (len(subtag) == 3 && !isAlpha(subtag)):
+			subtags[i] = strings.ToUpper(subtag)
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// isAlpha reports whether s consists only of ASCII letters.
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
 }
 
 // DataType holds the parsed system profiler data for SPInternationalDataType.
@@ -0,0 +1,101 @@
+package international
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInternationalDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No international data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+
+	// Test String() method
+	if str := DataType.String(); str == "" {
+		t.Error("String() method should not return empty string")
+	}
+}
+
+func TestInternationalCombined(t *testing.T) {
+	input := `[
+		{
+			"_name": "system_locale",
+			"boot_locale": "de_DE",
+			"system_languages": ["de-DE", "en"]
+		},
+		{
+			"_name": "user_settings",
+			"user_enabled_input_sources": ["German", "U.S."],
+			"user_locale": "de_DE@calendar=gregorian",
+			"user_measurement_units": "Centimeters",
+			"user_preferred_interface_languages": ["de_DE", "zh-hans_cn"],
+			"user_time_zone": "Europe/Berlin"
+		}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal international data: %v", err)
+	}
+
+	settings := Combined(items)
+	if settings.Name != "system_locale" || settings.UserTimeZone != "Europe/Berlin" {
+		t.Errorf("Combined should merge system and user items, got %+v", settings)
+	}
+	if tag := settings.SystemLocaleTag(); tag != "de-DE" {
+		t.Errorf("Expected system locale de-DE, got %q", tag)
+	}
+	if !settings.HasLocale("de-de") {
+		t.Errorf("Expected user locale de-DE, got %q", settings.UserLocaleTag())
+	}
+	if metric, ok := settings.UsesMetric(); !metric || !ok {
+		t.Error("Expected metric measurement units")
+	}
+	if _, ok := (DataTypeItem{}).UsesMetric(); ok {
+		t.Error("Expected unknown measurement units for an empty item")
+	}
+	if metric, ok := (DataTypeItem{UserMeasurementUnits: "Inches"}).UsesMetric(); metric || !ok {
+		t.Error("Expected imperial measurement units for inches")
+	}
+	if len(settings.UserEnabledInputSources) != 2 {
+		t.Errorf("Expected 2 input sources, got %v", settings.UserEnabledInputSources)
+	}
+
+	tags := settings.PreferredLanguageTags()
+	if len(tags) != 2 || tags[0] != "de-DE" || tags[1] != "zh-Hans-CN" {
+		t.Errorf("Unexpected preferred language tags: %v", tags)
+	}
+}
+
+func TestNormalizeLanguageTag(t *testing.T) {
+	cases := map[string]string{
+		"en_US":         "en-US",
+		"EN":            "en",
+		"es_419":        "es-419",
+		"sr_Latn_RS":    "sr-Latn-RS",
+		"ja_JP@cal=jpn": "ja-JP",
+		"":              "",
+	}
+	for input, want := range cases {
+		if got := NormalizeLanguageTag(input); got != want {
+			t.Errorf("NormalizeLanguageTag(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
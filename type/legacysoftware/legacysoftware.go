@@ -6,12 +6,29 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPLegacySoftwareDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name         string             `json:"_name"`
+	ArchKind     normalize.ArchKind `json:"arch_kind,omitempty"`
+	LastModified string             `json:"lastModified,omitempty"`
+	Path         string             `json:"path,omitempty"`
+	Version      string             `json:"version,omitempty"`
+}
+
+// ThirtyTwoBit returns the legacy entries that only contain 32-bit code.
+// Entries without an arch_kind are assumed to be 32-bit, which is the reason
+// system_profiler lists software as legacy.
+func ThirtyTwoBit(items []DataTypeItem) []DataTypeItem {
+	var matched []DataTypeItem
+	for _, item := range items {
+		if item.ArchKind == "" || item.ArchKind.Is32Bit() {
+			matched = append(matched, item)
+		}
+	}
+	return matched
 }
 
 // DataType holds the parsed system profiler data for SPLegacySoftwareDataType.
@@ -0,0 +1,89 @@
+package legacysoftware
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
+)
+
+func TestLegacySoftwareDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No legacy software data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestLegacySoftwareParse(t *testing.T) {
+	output := `{
+		"SPLegacySoftwareDataType": [
+			{
+				"_name": "legacy_software",
+				"_items": [
+					{"_name": "Old Installer", "arch_kind": "arch_i32", "lastModified": "2018-03-14T09:26:53Z", "path": "/Applications/Old Installer.app", "version": "2.0"},
+					{"_name": "Universal Tool", "arch_kind": "arch_arm_i64", "path": "/Applications/Universal Tool.app"}
+				]
+			}
+		]
+	}`
+
+	data, err := common.ParseData[DataTypeItem](common.SPLegacySoftwareDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse legacy software: %v", err)
+	}
+	if len(data.Item) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(data.Item))
+	}
+
+	old := data.Item[0]
+	if old.ArchKind != normalize.ArchIntel32 || !old.ArchKind.Is32Bit() {
+		t.Errorf("Expected %s to be 32-bit Intel, got %q", old.Name, old.ArchKind)
+	}
+	if old.Version != "2.0" || old.LastModified == "" {
+		t.Errorf("Unexpected fields for %s: %+v", old.Name, old)
+	}
+	if legacy := ThirtyTwoBit(data.Item); len(legacy) != 1 || legacy[0].Name != "Old Installer" {
+		t.Errorf("Expected only Old Installer to be 32-bit, got %+v", legacy)
+	}
+}
+
+func TestThirtyTwoBit(t *testing.T) {
+	input := `[
+		{"_name": "Old Installer", "arch_kind": "arch_i32", "path": "/Applications/Old Installer.app", "version": "2.0"},
+		{"_name": "Classic Tool", "arch_kind": "arch_ppc", "path": "/Applications/Classic Tool.app"},
+		{"_name": "Unknown Helper", "path": "/Library/Application Support/Helper.app"},
+		{"_name": "Fat Binary", "arch_kind": "arch_i32_i64", "path": "/Applications/Fat Binary.app"}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal legacy software: %v", err)
+	}
+
+	legacy := ThirtyTwoBit(items)
+	if len(legacy) != 3 {
+		t.Fatalf("Expected 3 32-bit entries, got %d: %+v", len(legacy), legacy)
+	}
+	for i, name := range []string{"Old Installer", "Classic Tool", "Unknown Helper"} {
+		if legacy[i].Name != name {
+			t.Errorf("Entry %d: expected %s, got %s", i, name, legacy[i].Name)
+		}
+	}
+}
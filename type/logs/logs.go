@@ -3,15 +3,97 @@ package logs
 
 import (
 	"fmt"
+	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
+// Kind classifies a log entry.
+type Kind string
+
+const (
+	KindLog         Kind = "log"
+	KindCrashReport Kind = "crash_report"
+	KindKernelPanic Kind = "kernel_panic"
+)
+
 // DataTypeItem represents the structure of SPLogsDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name         string `json:"_name"`
+	ByteSize     int64  `json:"byteSize,omitempty"`
+	Contents     string `json:"contents,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Source       string `json:"source,omitempty"`
+}
+
+// LastModifiedTime returns the parsed last modification time of the entry.
+func (d DataTypeItem) LastModifiedTime() (time.Time, error) {
+	return common.ParseTimestamp(d.LastModified)
+}
+
+// Kind classifies the entry as a kernel panic, crash report or plain log
+// based on its name and source path.
+func (d DataTypeItem) Kind() Kind {
+	name := strings.ToLower(d.Name + " " + d.Source)
+	switch {
+	case strings.Contains(name, ".panic"), strings.Contains(name, "panic-full"), strings.Contains(name, "kernel panic"):
+		return KindKernelPanic
+	case strings.Contains(name, ".crash"), strings.Contains(name, ".ips"), strings.Contains(name, "diagnosticreports"):
+		return KindCrashReport
+	}
+	return KindLog
+}
+
+// Excerpt returns at most n bytes of the entry contents, cut at a line boundary when possible.
+// The excerpt never ends in the middle of a UTF-8 character; a negative n returns "".
+func (d DataTypeItem) Excerpt(n int) string {
+	if n < 0 {
+		n = 0
+	}
+	if len(d.Contents) <= n {
+		return d.Contents
+	}
+	for n > 0 && !utf8.RuneStart(d.Contents[n]) {
+		n--
+	}
+	excerpt := d.Contents[:n]
+	if i := strings.LastIndexByte(excerpt, '\n'); i > 0 {
+		excerpt = excerpt[:i]
+	}
+	return excerpt
+}
+
+// KernelPanics returns the kernel panic reports modified within [from, to].
+func KernelPanics(items []DataTypeItem, from, to time.Time) []DataTypeItem {
+	return filter(items, KindKernelPanic, from, to)
+}
+
+// CrashReports returns the crash reports modified within [from, to].
+func CrashReports(items []DataTypeItem, from, to time.Time) []DataTypeItem {
+	return filter(items, KindCrashReport, from, to)
+}
+
+// filter returns the entries of kind whose modification time falls within
+// [from, to]. A zero from or to leaves that side of the window open.
+func filter(items []DataTypeItem, kind Kind, from, to time.Time) []DataTypeItem {
+	var matched []DataTypeItem
+	for _, item := range items {
+		if item.Kind() != kind {
+			continue
+		}
+		modified, err := item.LastModifiedTime()
+		if err != nil {
+			continue
+		}
+		if (!from.IsZero() && modified.Before(from)) || (!to.IsZero() && modified.After(to)) {
+			continue
+		}
+		matched = append(matched, item)
+	}
+	return matched
 }
 
 // DataType holds the parsed system profiler data for SPLogsDataType.
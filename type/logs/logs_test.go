@@ -3,6 +3,8 @@ package logs
 import (
 	"encoding/json"
 	"testing"
+	"time"
+	"unicode/utf8"
 )
 
 func TestLogsDataType(t *testing.T) {
@@ -68,7 +70,50 @@ func TestLogsFields(t *testing.T) {
 			t.Errorf("Log item %d should have a name", i)
 		}
 
-		// Note: Source field is not implemented in the current DataTypeItem struct
-		// TODO: Add specific fields when implementing the full logs structure
+		t.Logf("Log %d: %s (source: %s, kind: %s)", i, item.Name, item.Source, item.Kind())
+	}
+}
+
+func TestLogsTimeWindow(t *testing.T) {
+	input := `[
+		{"_name": "System Log", "source": "/var/log/system.log", "lastModified": "2024-05-03T08:00:00Z", "byteSize": 2048, "contents": "line one\nline two\n"},
+		{"_name": "Kernel-2024-05-01-102233.panic", "source": "/Library/Logs/DiagnosticReports/Kernel-2024-05-01-102233.panic", "lastModified": "2024-05-01T10:22:33Z"},
+		{"_name": "panic-full-2024-04-01-090000.ips", "source": "/Library/Logs/DiagnosticReports/panic-full-2024-04-01-090000.ips", "lastModified": "2024-04-01T09:00:00Z"},
+		{"_name": "Safari_2024-05-02-120000.ips", "source": "/Library/Logs/DiagnosticReports/Safari_2024-05-02-120000.ips", "lastModified": "2024-05-02 12:00:00 +0000"}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal logs: %v", err)
+	}
+
+	from := time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	panics := KernelPanics(items, from, to)
+	if len(panics) != 1 || panics[0].Name != "Kernel-2024-05-01-102233.panic" {
+		t.Errorf("Unexpected kernel panics in window: %+v", panics)
+	}
+	if all := KernelPanics(items, time.Time{}, time.Time{}); len(all) != 2 {
+		t.Errorf("Expected 2 kernel panics without a window, got %d", len(all))
+	}
+
+	crashes := CrashReports(items, from, to)
+	if len(crashes) != 1 || crashes[0].Kind() != KindCrashReport {
+		t.Errorf("Unexpected crash reports in window: %+v", crashes)
+	}
+
+	if items[0].Kind() != KindLog {
+		t.Errorf("Expected system log kind, got %s", items[0].Kind())
+	}
+	if excerpt := items[0].Excerpt(12); excerpt != "line one" {
+		t.Errorf("Unexpected excerpt: %q", excerpt)
+	}
+	if excerpt := items[0].Excerpt(-1); excerpt != "" {
+		t.Errorf("Expected an empty excerpt for a negative length, got %q", excerpt)
+	}
+	// "é" is two bytes; cutting after its first byte drops it entirely
+	if excerpt := (DataTypeItem{Contents: "café crème"}).Excerpt(4); excerpt != "caf" || !utf8.ValidString(excerpt) {
+		t.Errorf("Expected the excerpt to end on a character boundary, got %q", excerpt)
 	}
 }
@@ -2,16 +2,181 @@
 package managedclient
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// Source identifies whether managed preferences apply to the device or to a user.
+type Source string
+
+const (
+	SourceDevice  Source = "device"
+	SourceUser    Source = "user"
+	SourceUnknown Source = "unknown"
+)
+
+// ErrSettingNotFound is returned by Setting when no managed preference matches.
+var ErrSettingNotFound = errors.New("managed setting not found")
+
+// Preference represents a single managed preference key and its enforced value.
+type Preference struct {
+	Name  string      `json:"_name"`
+	Value interface{} `json:"value,omitempty"`
+	State string      `json:"state,omitempty"`
+}
+
+// Domain represents a managed preference domain such as com.apple.screensaver.
+type Domain struct {
+	Name  string       `json:"_name"`
+	Items []Preference `json:"_items,omitempty"`
+}
+
+// Enrollment represents the MDM enrollment metadata reported for the device.
+type Enrollment struct {
+	EnrolledViaDEP string `json:"spmanagedclient_enrolled_via_dep,omitempty"`
+	EnrollmentType string `json:"spmanagedclient_enrollment_type,omitempty"`
+	MDMServer      string `json:"spmanagedclient_mdm_server,omitempty"`
+	Organization   string `json:"spmanagedclient_organization,omitempty"`
+	UserApproved   string `json:"spmanagedclient_user_approved,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPManagedClientDataType.
+// Each item groups the managed preference domains applied at one level,
+// either the computer or a specific user. Enrollment is nil when the level
+// reports no enrollment metadata.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name       string      `json:"_name"`
+	Level      string      `json:"spmanagedclient_level,omitempty"`
+	UserName   string      `json:"spmanagedclient_user_name,omitempty"`
+	Enrollment *Enrollment `json:"spmanagedclient_enrollment,omitempty"`
+	Items      []Domain    `json:"_items,omitempty"`
+}
+
+// Source reports whether the item's preferences apply to the device or a user.
+func (d DataTypeItem) Source() Source {
+	level := strings.ToLower(d.Level)
+	if level == "" {
+		level = strings.ToLower(d.Name)
+	}
+	switch {
+	case strings.Contains(level, "computer"), strings.Contains(level, "device"):
+		return SourceDevice
+	case strings.Contains(level, "user"):
+		return SourceUser
+	}
+	return SourceUnknown
+}
+
+// Domain returns the managed preference domain with the given name.
+func (d DataTypeItem) Domain(name string) (Domain, bool) {
+	for _, domain := range d.Items {
+		if domain.Name == name {
+			return domain, true
+		}
+	}
+	return Domain{}, false
+}
+
+// Preference returns the preference with the given key.
+func (d Domain) Preference(key string) (Preference, bool) {
+	for _, pref := range d.Items {
+		if pref.Name == key {
+			return pref, true
+		}
+	}
+	return Preference{}, false
+}
+
+// IsDEP reports whether the device was enrolled through Automated Device Enrollment.
+func (e Enrollment) IsDEP() bool {
+	return normalize.Bool(e.EnrolledViaDEP)
+}
+
+// IsUserApproved reports whether the MDM enrollment is user approved.
+func (e Enrollment) IsUserApproved() bool {
+	return normalize.Bool(e.UserApproved)
+}
+
+// String returns the preference value formatted as a string.
+func (p Preference) String() string {
+	if p.Value == nil {
+		return ""
+	}
+	if s, ok := p.Value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(p.Value)
+}
+
+// Bool returns the preference value as a boolean and whether it could be interpreted as one.
+func (p Preference) Bool() (bool, bool) {
+	switch v := p.Value.(type) {
+	case bool:
+		return v, true
+	case float64:
+		return v != 0, true
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "yes", "true", "on", "1":
+			return true, true
+		case "no", "false", "off", "0":
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// Int returns the preference value as an integer and whether it could be interpreted as one.
+func (p Preference) Int() (int64, bool) {
+	switch v := p.Value.(type) {
+	case float64:
+		return int64(v), true
+	case string:
+		n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// Setting looks up a managed preference across all levels, preferring
+// device-level values over user-level ones.
+func Setting(domain, key string) (Preference, error) {
+	data, err := GetDataType()
+	if err != nil {
+		return Preference{}, err
+	}
+	return lookupSetting(data.Item, domain, key)
+}
+
+// lookupSetting implements Setting over a list of items.
+func lookupSetting(items []DataTypeItem, domain, key string) (Preference, error) {
+	var fallback *Preference
+	for _, item := range items {
+		dom, ok := item.Domain(domain)
+		if !ok {
+			continue
+		}
+		pref, ok := dom.Preference(key)
+		if !ok {
+			continue
+		}
+		if item.Source() == SourceDevice {
+			return pref, nil
+		}
+		if fallback == nil {
+			fallback = &pref
+		}
+	}
+	if fallback != nil {
+		return *fallback, nil
+	}
+	return Preference{}, fmt.Errorf("%w: %s %s", ErrSettingNotFound, domain, key)
 }
 
 // DataType holds the parsed system profiler data for SPManagedClientDataType.
@@ -25,7 +190,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPManagedClientDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPManagedClientDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize managedclient data: %w", err)
 			return
@@ -2,7 +2,11 @@ package managedclient
 
 import (
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
 func TestManagedClientDataType(t *testing.T) {
@@ -71,3 +75,74 @@ func TestManagedClientFields(t *testing.T) {
 		t.Logf("Managed client %d: %s", i, item.Name)
 	}
 }
+
+func TestManagedClientSetting(t *testing.T) {
+	output := `{"SPManagedClientDataType": [
+		{
+			"_name": "User Level",
+			"spmanagedclient_user_name": "alice",
+			"_items": [
+				{"_name": "com.apple.screensaver", "_items": [
+					{"_name": "idleTime", "value": 900, "state": "Always"}
+				]}
+			]
+		},
+		{
+			"_name": "Computer Level",
+			"spmanagedclient_enrollment": {
+				"spmanagedclient_enrolled_via_dep": "Yes",
+				"spmanagedclient_user_approved": "Yes"
+			},
+			"_items": [
+				{"_name": "com.apple.screensaver", "_items": [
+					{"_name": "idleTime", "value": 300, "state": "Always"},
+					{"_name": "askForPassword", "value": true, "state": "Always"}
+				]}
+			]
+		}
+	]}`
+
+	// Every level is a top-level entry of the data type
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPManagedClientDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse managed client data: %v", err)
+	}
+	items := data.Item
+	if len(items) != 2 {
+		t.Fatalf("Expected two levels, got %d", len(items))
+	}
+
+	if items[0].Source() != SourceUser || items[1].Source() != SourceDevice {
+		t.Errorf("Unexpected sources: %s, %s", items[0].Source(), items[1].Source())
+	}
+	if items[0].Enrollment != nil {
+		t.Errorf("Expected no enrollment at user level, got %+v", items[0].Enrollment)
+	}
+	if items[1].Enrollment == nil || !items[1].Enrollment.IsDEP() || !items[1].Enrollment.IsUserApproved() {
+		t.Error("Expected DEP, user approved enrollment")
+	}
+	if data, err := json.Marshal(items[0]); err != nil || strings.Contains(string(data), "spmanagedclient_enrollment") {
+		t.Errorf("Expected no enrollment in %s (%v)", data, err)
+	}
+
+	// Device-level values take precedence over user-level ones
+	pref, err := lookupSetting(items, "com.apple.screensaver", "idleTime")
+	if err != nil {
+		t.Fatalf("Expected idleTime setting: %v", err)
+	}
+	if n, ok := pref.Int(); !ok || n != 300 {
+		t.Errorf("Expected idleTime 300, got %v (ok=%v)", n, ok)
+	}
+
+	pref, err = lookupSetting(items, "com.apple.screensaver", "askForPassword")
+	if err != nil {
+		t.Fatalf("Expected askForPassword setting: %v", err)
+	}
+	if b, ok := pref.Bool(); !ok || !b {
+		t.Errorf("Expected askForPassword true, got %v (ok=%v)", b, ok)
+	}
+
+	if _, err := lookupSetting(items, "com.apple.loginwindow", "GuestEnabled"); !errors.Is(err, ErrSettingNotFound) {
+		t.Errorf("Expected ErrSettingNotFound, got %v", err)
+	}
+}
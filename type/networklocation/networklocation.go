@@ -6,12 +6,103 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// IPv4 represents the IPv4 configuration of a service.
+type IPv4 struct {
+	Addresses    []string `json:"Addresses,omitempty"`
+	ConfigMethod string   `json:"ConfigMethod,omitempty"`
+	Router       string   `json:"Router,omitempty"`
+	SubnetMasks  []string `json:"SubnetMasks,omitempty"`
+}
+
+// IPv6 represents the IPv6 configuration of a service.
+type IPv6 struct {
+	Addresses    []string `json:"Addresses,omitempty"`
+	ConfigMethod string   `json:"ConfigMethod,omitempty"`
+	Router       string   `json:"Router,omitempty"`
+}
+
+// DNS represents the DNS configuration of a service.
+type DNS struct {
+	SearchDomains   []string `json:"SearchDomains,omitempty"`
+	ServerAddresses []string `json:"ServerAddresses,omitempty"`
+}
+
+// Proxies represents the proxy configuration of a service.
+type Proxies struct {
+	ExceptionsList        []string `json:"ExceptionsList,omitempty"`
+	FTPPassive            string   `json:"FTPPassive,omitempty"`
+	HTTPEnable            string   `json:"HTTPEnable,omitempty"`
+	HTTPPort              int      `json:"HTTPPort,omitempty"`
+	HTTPProxy             string   `json:"HTTPProxy,omitempty"`
+	HTTPSEnable           string   `json:"HTTPSEnable,omitempty"`
+	HTTPSPort             int      `json:"HTTPSPort,omitempty"`
+	HTTPSProxy            string   `json:"HTTPSProxy,omitempty"`
+	ProxyAutoConfigEnable string   `json:"ProxyAutoConfigEnable,omitempty"`
+	ProxyAutoConfigURL    string   `json:"ProxyAutoConfigURLString,omitempty"`
+	SOCKSEnable           string   `json:"SOCKSEnable,omitempty"`
+	SOCKSPort             int      `json:"SOCKSPort,omitempty"`
+	SOCKSProxy            string   `json:"SOCKSProxy,omitempty"`
+}
+
+// Service represents a network service configured in a location.
+type Service struct {
+	Name      string   `json:"_name"`
+	DNS       *DNS     `json:"DNS,omitempty"`
+	Hardware  string   `json:"hardware,omitempty"`
+	Interface string   `json:"interface,omitempty"`
+	IPv4      *IPv4    `json:"IPv4,omitempty"`
+	IPv6      *IPv6    `json:"IPv6,omitempty"`
+	Proxies   *Proxies `json:"Proxies,omitempty"`
+	Type      string   `json:"type,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPNetworkLocationDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name     string    `json:"_name"`
+	IsActive string    `json:"spnetworklocation_isActive,omitempty"`
+	Services []Service `json:"spnetworklocation_services,omitempty"`
+}
+
+// Active reports whether this is the currently active location.
+func (d DataTypeItem) Active() bool {
+	return normalize.Bool(d.IsActive)
+}
+
+// Service returns the service with the given name.
+func (d DataTypeItem) Service(name string) (Service, bool) {
+	for _, service := range d.Services {
+		if service.Name == name {
+			return service, true
+		}
+	}
+	return Service{}, false
+}
+
+// UsesProxy reports whether any HTTP, HTTPS, SOCKS or automatic proxy is
+// enabled. It is false for a service without proxy settings.
+func (p *Proxies) UsesProxy() bool {
+	if p == nil {
+		return false
+	}
+	for _, flag := range []string{p.HTTPEnable, p.HTTPSEnable, p.SOCKSEnable, p.ProxyAutoConfigEnable} {
+		if normalize.Bool(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveLocation returns the active location.
+func ActiveLocation(items []DataTypeItem) (DataTypeItem, bool) {
+	for _, item := range items {
+		if item.Active() {
+			return item, true
+		}
+	}
+	return DataTypeItem{}, false
 }
 
 // DataType holds the parsed system profiler data for SPNetworkLocationDataType.
@@ -0,0 +1,85 @@
+package networklocation
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNetworkLocationDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No network location data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestActiveLocation(t *testing.T) {
+	input := `[
+		{"_name": "Office", "spnetworklocation_isActive": "no"},
+		{
+			"_name": "Automatic",
+			"spnetworklocation_isActive": "yes",
+			"spnetworklocation_services": [
+				{
+					"_name": "Wi-Fi",
+					"DNS": {"ServerAddresses": ["10.0.0.1"], "SearchDomains": ["corp.example.com"]},
+					"IPv4": {"ConfigMethod": "DHCP"},
+					"IPv6": {"ConfigMethod": "Automatic"},
+					"Proxies": {"HTTPEnable": "1", "HTTPProxy": "proxy.corp.example.com", "HTTPPort": 3128},
+					"interface": "en0",
+					"type": "AirPort"
+				},
+				{"_name": "Thunderbolt Bridge", "IPv4": {"ConfigMethod": "DHCP"}, "interface": "bridge0"}
+			]
+		}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal network locations: %v", err)
+	}
+
+	active, ok := ActiveLocation(items)
+	if !ok || active.Name != "Automatic" {
+		t.Fatalf("Expected Automatic to be active, got %+v", active)
+	}
+
+	wifi, ok := active.Service("Wi-Fi")
+	if !ok {
+		t.Fatal("Expected a Wi-Fi service")
+	}
+	if !wifi.Proxies.UsesProxy() || wifi.Proxies.HTTPPort != 3128 {
+		t.Errorf("Expected an HTTP proxy on port 3128, got %+v", wifi.Proxies)
+	}
+	if len(wifi.DNS.ServerAddresses) != 1 || wifi.IPv4.ConfigMethod != "DHCP" {
+		t.Errorf("Unexpected Wi-Fi configuration: %+v", wifi)
+	}
+
+	bridge, _ := active.Service("Thunderbolt Bridge")
+	if bridge.Proxies.UsesProxy() || bridge.DNS != nil {
+		t.Error("Expected no proxy or DNS settings on the bridge service")
+	}
+	data, err := json.Marshal(bridge)
+	if err != nil {
+		t.Fatalf("Failed to marshal service: %v", err)
+	}
+	if strings.Contains(string(data), "Proxies") || strings.Contains(string(data), "IPv6") {
+		t.Errorf("Expected missing settings to be omitted, got %s", data)
+	}
+}
@@ -3,15 +3,116 @@ package networkvolume
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
+)
+
+// Protocol identifies the file sharing protocol of a network volume.
+type Protocol string
+
+const (
+	ProtocolSMB     Protocol = "smb"
+	ProtocolNFS     Protocol = "nfs"
+	ProtocolAFP     Protocol = "afp"
+	ProtocolWebDAV  Protocol = "webdav"
+	ProtocolFTP     Protocol = "ftp"
+	ProtocolUnknown Protocol = "unknown"
 )
 
 // DataTypeItem represents the structure of SPNetworkVolumeDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name          string `json:"_name"`
+	Automounted   string `json:"spnetworkvolume_automounted,omitempty"`
+	FsTypeName    string `json:"spnetworkvolume_fstypename,omitempty"`
+	MountFromName string `json:"spnetworkvolume_mntfromname,omitempty"`
+	MountOnName   string `json:"spnetworkvolume_fsmtnonname,omitempty"`
+}
+
+// Protocol returns the file sharing protocol derived from the file system type.
+func (d DataTypeItem) Protocol() Protocol {
+	switch strings.ToLower(d.FsTypeName) {
+	case "smbfs", "cifs", "smb":
+		return ProtocolSMB
+	case "nfs":
+		return ProtocolNFS
+	case "afpfs", "afp":
+		return ProtocolAFP
+	case "webdav":
+		return ProtocolWebDAV
+	case "ftp":
+		return ProtocolFTP
+	}
+	return ProtocolUnknown
+}
+
+// IsAutomounted reports whether the volume was mounted by the automounter.
+func (d DataTypeItem) IsAutomounted() bool {
+	return normalize.Bool(d.Automounted)
+}
+
+// MountPoint returns the local path the volume is mounted on.
+func (d DataTypeItem) MountPoint() string {
+	return d.MountOnName
+}
+
+// Server returns the host serving the volume, without any user name.
+func (d DataTypeItem) Server() string {
+	server, _ := d.splitSource()
+	return server
+}
+
+// Share returns the exported share or path on the server. NFS style
+// "server:/export" sources keep their leading "/".
+func (d DataTypeItem) Share() string {
+	_, share := d.splitSource()
+	return share
+}
+
+// splitSource splits sources such as "//user@server/share", "server:/export"
+// or "afp://server/share" into server and share.
+func (d DataTypeItem) splitSource() (string, string) {
+	source := d.MountFromName
+	if _, rest, found := strings.Cut(source, "://"); found {
+		source = rest
+	}
+	source = strings.TrimPrefix(source, "//")
+	var server, share string
+	if host, path, found := strings.Cut(source, ":/"); found && !strings.Contains(host, "/") {
+		server, share = host, "/"+path
+	} else {
+		server, share, _ = strings.Cut(source, "/")
+	}
+	if i := strings.LastIndexByte(server, '@'); i >= 0 {
+		server = server[i+1:]
+	}
+	return server, share
+}
+
+// ByProtocol returns the volumes mounted with protocol.
+func ByProtocol(items []DataTypeItem, protocol Protocol) []DataTypeItem {
+	var matched []DataTypeItem
+	for _, item := range items {
+		if item.Protocol() == protocol {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+// StaleSMB returns the SMB volumes whose server is no longer reachable, as
+// reported by reachable. Such mounts hang Finder and applications that touch
+// them and are usually left over from another network.
+func StaleSMB(items []DataTypeItem, reachable func(server string) bool) []DataTypeItem {
+	var stale []DataTypeItem
+	for _, item := range ByProtocol(items, ProtocolSMB) {
+		if !reachable(item.Server()) {
+			stale = append(stale, item)
+		}
+	}
+	return stale
 }
 
 // DataType holds the parsed system profiler data for SPNetworkVolumeDataType.
@@ -0,0 +1,71 @@
+package networkvolume
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNetworkVolumeDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No network volume data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestNetworkVolumeSource(t *testing.T) {
+	input := `[
+		{"_name": "projects", "spnetworkvolume_automounted": "no", "spnetworkvolume_fstypename": "smbfs", "spnetworkvolume_mntfromname": "//alice@fileserver.corp.example.com/projects", "spnetworkvolume_fsmtnonname": "/Volumes/projects"},
+		{"_name": "home", "spnetworkvolume_automounted": "yes", "spnetworkvolume_fstypename": "nfs", "spnetworkvolume_mntfromname": "nas.local:/export/home", "spnetworkvolume_fsmtnonname": "/System/Volumes/Data/home"},
+		{"_name": "archive", "spnetworkvolume_fstypename": "afpfs", "spnetworkvolume_mntfromname": "afp://oldmac.local/archive", "spnetworkvolume_fsmtnonname": "/Volumes/archive"}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal network volumes: %v", err)
+	}
+
+	cases := []struct {
+		protocol    Protocol
+		server      string
+		share       string
+		automounted bool
+	}{
+		{ProtocolSMB, "fileserver.corp.example.com", "projects", false},
+		{ProtocolNFS, "nas.local", "/export/home", true},
+		{ProtocolAFP, "oldmac.local", "archive", false},
+	}
+	for i, want := range cases {
+		item := items[i]
+		if item.Protocol() != want.protocol || item.Server() != want.server || item.Share() != want.share || item.IsAutomounted() != want.automounted {
+			t.Errorf("Volume %s: got %s %s %s %v, want %+v", item.Name, item.Protocol(), item.Server(), item.Share(), item.IsAutomounted(), want)
+		}
+	}
+
+	if smb := ByProtocol(items, ProtocolSMB); len(smb) != 1 || smb[0].MountPoint() != "/Volumes/projects" {
+		t.Errorf("Unexpected SMB volumes: %+v", smb)
+	}
+
+	reachable := func(server string) bool { return server != "fileserver.corp.example.com" }
+	if stale := StaleSMB(items, reachable); len(stale) != 1 || stale[0].Name != "projects" {
+		t.Errorf("Unexpected stale SMB volumes: %+v", stale)
+	}
+	if stale := StaleSMB(items, func(string) bool { return true }); len(stale) != 0 {
+		t.Errorf("Expected no stale SMB volumes, got %+v", stale)
+	}
+}
@@ -5,17 +5,13 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/samburba/go-system-profiler/v2/disk"
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // Volume represents an NVMe volume.
-type Volume struct {
-	Name        string `json:"_name"`
-	BsdName     string `json:"bsd_name,omitempty"`
-	IOContent   string `json:"iocontent,omitempty"`
-	Size        string `json:"size,omitempty"`
-	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
-}
+type Volume = disk.Volume
 
 // NVMeDevice represents an NVMe device.
 type NVMeDevice struct {
@@ -35,11 +31,56 @@ type NVMeDevice struct {
 }
 
 // DataTypeItem represents the structure of SPNVMeDataType.
+// Each item is an NVMe controller and its attached drives.
 type DataTypeItem struct {
 	Name  string       `json:"_name"`
 	Items []NVMeDevice `json:"_items,omitempty"`
 }
 
+// Device returns the device as a disk.Device, the shape drives have on every
+// storage bus.
+func (d NVMeDevice) Device() disk.Device {
+	return disk.Device{
+		Name:             d.Name,
+		BsdName:          d.BsdName,
+		DetachableDrive:  d.DetachableDrive,
+		DeviceModel:      d.DeviceModel,
+		DeviceRevision:   d.DeviceRevision,
+		DeviceSerial:     d.DeviceSerial,
+		PartitionMapType: d.PartitionMapType,
+		RemovableMedia:   d.RemovableMedia,
+		Size:             d.Size,
+		SizeInBytes:      d.SizeInBytes,
+		SmartStatus:      d.SmartStatus,
+		Volumes:          d.Volumes,
+	}
+}
+
+// Capacity returns the drive size, preferring the exact byte count.
+func (d NVMeDevice) Capacity() (normalize.ByteSize, error) {
+	return d.Device().Capacity()
+}
+
+// SMARTVerified reports whether the drive's SMART status is verified.
+func (d NVMeDevice) SMARTVerified() bool {
+	return d.Device().SMARTVerified()
+}
+
+// IsRemovable reports whether the drive has removable media.
+func (d NVMeDevice) IsRemovable() bool {
+	return d.Device().IsRemovable()
+}
+
+// IsDetachable reports whether the drive is detachable.
+func (d NVMeDevice) IsDetachable() bool {
+	return d.Device().IsDetachable()
+}
+
+// SupportsTRIM reports whether TRIM is enabled for the device.
+func (d NVMeDevice) SupportsTRIM() bool {
+	return normalize.Bool(d.SpnvmeTrimSupport)
+}
+
 // DataType holds the parsed system profiler data for SPNVMeDataType.
 var DataType *common.DataType[DataTypeItem]
 
@@ -51,7 +92,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPNVMeDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPNVMeDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize nvme data: %w", err)
 			return
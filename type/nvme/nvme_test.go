@@ -91,3 +91,46 @@ func TestNVMeFields(t *testing.T) {
 		}
 	}
 }
+
+func TestNVMeNormalizedFields(t *testing.T) {
+	input := `{
+		"_name": "Apple SSD Controller",
+		"_items": [
+			{
+				"_name": "APPLE SSD AP0512Q",
+				"detachable_drive": "no",
+				"removable_media": "no",
+				"size": "500.28 GB",
+				"smart_status": "Verified",
+				"spnvme_trim_support": "Yes",
+				"volumes": [{"_name": "disk0s1", "size": "524.3 MB"}]
+			}
+		]
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal NVMe data: %v", err)
+	}
+
+	device := item.Items[0]
+	size, err := device.Capacity()
+	if err != nil || size != 500280000000 {
+		t.Errorf("Expected 500.28 GB, got %v (%v)", size, err)
+	}
+	if !device.SupportsTRIM() || !device.SMARTVerified() || device.IsRemovable() || device.IsDetachable() {
+		t.Errorf("Unexpected device flags: %+v", device)
+	}
+	if d := device.Device(); d.Name != "APPLE SSD AP0512Q" || d.SmartStatus != "Verified" || len(d.Volumes) != 1 {
+		t.Errorf("Unexpected disk.Device: %+v", d)
+	}
+	if volume, err := device.Volumes[0].Capacity(); err != nil || volume != 524300000 {
+		t.Errorf("Expected 524.3 MB volume, got %v (%v)", volume, err)
+	}
+
+	// The exact byte count takes precedence over the rounded string
+	device.SizeInBytes = 500277790720
+	if size, _ := device.Capacity(); size != 500277790720 {
+		t.Errorf("Expected exact byte count, got %d", size)
+	}
+}
@@ -5,13 +5,24 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/samburba/go-system-profiler/v2/disk"
 	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
+// Device represents a drive attached to a Parallel ATA bus.
+type Device struct {
+	disk.Device
+	DeviceType string `json:"sppata_device_type,omitempty"`
+	Protocol   string `json:"sppata_protocol,omitempty"`
+	SocketType string `json:"sppata_socket_type,omitempty"`
+	UnitNumber string `json:"sppata_unit_number,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPParallelATADataType.
+// Each item is a Parallel ATA bus and its attached drives.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name  string   `json:"_name"`
+	Items []Device `json:"_items,omitempty"`
 }
 
 // DataType holds the parsed system profiler data for SPParallelATADataType.
@@ -25,7 +36,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPParallelATADataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPParallelATADataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize parallelata data: %w", err)
 			return
@@ -0,0 +1,71 @@
+package parallelata
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+)
+
+func TestParallelATADataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No Parallel ATA data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestParallelATAParse(t *testing.T) {
+	output := `{"SPParallelATADataType": [
+		{
+			"_name": "ATA Bus",
+			"_items": [
+				{
+					"_name": "PIONEER DVD-RW DVR-KD08A",
+					"removable_media": "yes",
+					"sppata_device_type": "ATAPI",
+					"sppata_protocol": "ATAPI",
+					"sppata_unit_number": "0"
+				}
+			]
+		},
+		{
+			"_name": "ATA Bus 2",
+			"_items": [
+				{"_name": "ST3500418AS", "bsd_name": "disk1", "size": "500.11 GB", "sppata_unit_number": "1"}
+			]
+		}
+	]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPParallelATADataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse Parallel ATA data: %v", err)
+	}
+	if len(data.Item) != 2 || data.Item[1].Name != "ATA Bus 2" {
+		t.Fatalf("Expected both buses, got %+v", data.Item)
+	}
+
+	optical := data.Item[0].Items[0]
+	if optical.DeviceType != "ATAPI" || optical.UnitNumber != "0" || !optical.IsRemovable() {
+		t.Errorf("Unexpected optical drive: %+v", optical)
+	}
+	drive := data.Item[1].Items[0]
+	if size, err := drive.Capacity(); err != nil || size != 500110000000 {
+		t.Errorf("Expected 500.11 GB, got %v (%v)", size, err)
+	}
+}
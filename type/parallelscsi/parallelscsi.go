@@ -5,13 +5,26 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/samburba/go-system-profiler/v2/disk"
 	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
+// Device represents a drive attached to a Parallel SCSI bus.
+type Device struct {
+	disk.Device
+	LUN      string `json:"spparallelscsi_lun,omitempty"`
+	TargetID string `json:"spparallelscsi_target_id,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPParallelSCSIDataType.
+// Each item is a Parallel SCSI controller and its attached drives.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name            string   `json:"_name"`
+	Items           []Device `json:"_items,omitempty"`
+	FirmwareVersion string   `json:"spparallelscsi_firmware_version,omitempty"`
+	InitiatorID     string   `json:"spparallelscsi_initiator_id,omitempty"`
+	Product         string   `json:"spparallelscsi_product,omitempty"`
+	Vendor          string   `json:"spparallelscsi_vendor,omitempty"`
 }
 
 // DataType holds the parsed system profiler data for SPParallelSCSIDataType.
@@ -25,7 +38,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPParallelSCSIDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPParallelSCSIDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize parallelscsi data: %w", err)
 			return
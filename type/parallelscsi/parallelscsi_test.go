@@ -0,0 +1,70 @@
+package parallelscsi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+)
+
+func TestParallelSCSIDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No Parallel SCSI data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestParallelSCSIParse(t *testing.T) {
+	output := `{"SPParallelSCSIDataType": [
+		{
+			"_name": "Adaptec 29160",
+			"_items": [
+				{
+					"_name": "QUANTUM ATLAS10K3",
+					"bsd_name": "disk4",
+					"detachable_drive": "yes",
+					"spparallelscsi_lun": "0",
+					"spparallelscsi_target_id": "2"
+				}
+			],
+			"spparallelscsi_initiator_id": "7",
+			"spparallelscsi_vendor": "Adaptec"
+		}
+	]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPParallelSCSIDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse Parallel SCSI data: %v", err)
+	}
+	if len(data.Item) != 1 {
+		t.Fatalf("Expected one controller, got %d", len(data.Item))
+	}
+
+	controller := data.Item[0]
+	if controller.Name != "Adaptec 29160" || controller.InitiatorID != "7" || controller.Vendor != "Adaptec" {
+		t.Errorf("Controller fields not decoded: %+v", controller)
+	}
+	if len(controller.Items) != 1 {
+		t.Fatalf("Expected one drive, got %d", len(controller.Items))
+	}
+	drive := controller.Items[0]
+	if drive.BsdName != "disk4" || drive.TargetID != "2" || drive.LUN != "0" || !drive.IsDetachable() {
+		t.Errorf("Unexpected drive: %+v", drive)
+	}
+}
@@ -3,14 +3,102 @@ package power
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
-// DataTypeItem represents the structure of SPPowerDataType.
+// ChargeInfo represents the charge state of the battery.
+type ChargeInfo struct {
+	AtWarnLevel   string `json:"sppower_battery_at_warn_level,omitempty"`
+	FullyCharged  string `json:"sppower_battery_fully_charged,omitempty"`
+	IsCharging    string `json:"sppower_battery_is_charging,omitempty"`
+	StateOfCharge int    `json:"sppower_battery_state_of_charge,omitempty"`
+}
+
+// HealthInfo represents the health of the battery.
+type HealthInfo struct {
+	CycleCount      int    `json:"sppower_battery_cycle_count,omitempty"`
+	Health          string `json:"sppower_battery_health,omitempty"`
+	MaximumCapacity string `json:"sppower_battery_health_maximum_capacity,omitempty"`
+}
+
+// ModelInfo represents the model information of the battery.
+type ModelInfo struct {
+	CellRevision     string `json:"sppower_battery_cell_revision,omitempty"`
+	DeviceName       string `json:"sppower_battery_device_name,omitempty"`
+	FirmwareVersion  string `json:"sppower_battery_firmware_version,omitempty"`
+	HardwareRevision string `json:"sppower_battery_hardware_revision,omitempty"`
+	Manufacturer     string `json:"sppower_battery_manufacturer,omitempty"`
+	SerialNumber     string `json:"sppower_battery_serial_number,omitempty"`
+}
+
+// DataTypeItem represents the structure of SPPowerDataType. Only the
+// "spbattery_information" item carries the battery fields.
 type DataTypeItem struct {
-	Name string `json:"_name"`
+	Name             string      `json:"_name"`
+	ChargeInfo       *ChargeInfo `json:"sppower_battery_charge_info,omitempty"`
+	HealthInfo       *HealthInfo `json:"sppower_battery_health_info,omitempty"`
+	ModelInfo        *ModelInfo  `json:"sppower_battery_model_info,omitempty"`
+	ChargerConnected string      `json:"sppower_battery_charger_connected,omitempty"`
+}
+
+// IsBattery reports whether the item describes the battery.
+func (d DataTypeItem) IsBattery() bool {
+	return d.HealthInfo != nil || d.ChargeInfo != nil
+}
+
+// CycleCount returns the battery cycle count, or 0 when it is unknown.
+func (d DataTypeItem) CycleCount() int {
+	if d.HealthInfo == nil {
+		return 0
+	}
+	return d.HealthInfo.CycleCount
+}
+
+// Healthy reports whether the battery condition is "Good" or "Normal". Any
+// other condition, such as "Service Recommended", means it should be replaced.
+func (d DataTypeItem) Healthy() bool {
+	if d.HealthInfo == nil {
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(d.HealthInfo.Health)) {
+	case "good", "normal":
+		return true
+	}
+	return false
+}
+
+// MaximumCapacityPercent returns the capacity of the battery relative to
+// its design capacity, e.g. 87 for "87%".
+func (d DataTypeItem) MaximumCapacityPercent() (int, error) {
+	if d.HealthInfo == nil {
+		return 0, fmt.Errorf("no battery health information")
+	}
+	value := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(d.HealthInfo.MaximumCapacity), "%"))
+	percent, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maximum capacity %q", d.HealthInfo.MaximumCapacity)
+	}
+	return percent, nil
+}
+
+// IsCharging reports whether the battery is charging.
+func (d DataTypeItem) IsCharging() bool {
+	return d.ChargeInfo != nil && normalize.Bool(d.ChargeInfo.IsCharging)
+}
+
+// Battery returns the battery item, if the Mac has a battery.
+func Battery(items []DataTypeItem) (DataTypeItem, bool) {
+	for _, item := range items {
+		if item.IsBattery() {
+			return item, true
+		}
+	}
+	return DataTypeItem{}, false
 }
 
 // DataType holds the parsed system profiler data for SPPowerDataType.
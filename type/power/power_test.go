@@ -64,3 +64,36 @@ func TestPowerFields(t *testing.T) {
 	// Log power information
 	t.Logf("Power data structure test passed")
 }
+
+func TestPowerBattery(t *testing.T) {
+	jsonData := []byte(`[
+		{
+			"_name": "spbattery_information",
+			"sppower_battery_charge_info": {"sppower_battery_is_charging": "TRUE", "sppower_battery_state_of_charge": 80},
+			"sppower_battery_health_info": {
+				"sppower_battery_cycle_count": 312,
+				"sppower_battery_health": "Good",
+				"sppower_battery_health_maximum_capacity": "87%"
+			}
+		},
+		{"_name": "sppower_information"}
+	]`)
+	var items []DataTypeItem
+	if err := json.Unmarshal(jsonData, &items); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	battery, ok := Battery(items)
+	if !ok {
+		t.Fatal("Expected a battery")
+	}
+	if battery.CycleCount() != 312 || !battery.Healthy() || !battery.IsCharging() {
+		t.Errorf("Unexpected battery state: %+v", battery.HealthInfo)
+	}
+	if percent, err := battery.MaximumCapacityPercent(); err != nil || percent != 87 {
+		t.Errorf("MaximumCapacityPercent() = %d (%v), want 87", percent, err)
+	}
+	if _, ok := Battery(items[1:]); ok {
+		t.Error("Expected no battery without battery information")
+	}
+}
@@ -3,15 +3,78 @@ package printers
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// CupsFilter represents a CUPS filter used by a printer's driver.
+type CupsFilter struct {
+	Name        string `json:"_name"`
+	Path        string `json:"filter path,omitempty"`
+	Permissions string `json:"filter permissions,omitempty"`
+	Version     string `json:"filter version,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPPrintersDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name              string       `json:"_name"`
+	CreationDate      string       `json:"creationDate,omitempty"`
+	CupsFilters       []CupsFilter `json:"cups filters,omitempty"`
+	CupsVersion       string       `json:"cupsversion,omitempty"`
+	Default           string       `json:"default,omitempty"`
+	DriverVersion     string       `json:"driverversion,omitempty"`
+	FaxSupport        string       `json:"fax support,omitempty"`
+	PPD               string       `json:"ppd,omitempty"`
+	PPDFileVersion    string       `json:"ppdfileversion,omitempty"`
+	PrinterCommands   string       `json:"printer-commandset,omitempty"`
+	PrinterPDEVersion string       `json:"printerpdeversion,omitempty"`
+	PrinterSharing    string       `json:"printersharing,omitempty"`
+	PostScriptVersion string       `json:"psversion,omitempty"`
+	Scanner           string       `json:"scanner,omitempty"`
+	ScannerUUID       string       `json:"scanner UUID,omitempty"`
+	ScannerAppBundle  string       `json:"scannerappbundlepath,omitempty"`
+	Shared            string       `json:"shared,omitempty"`
+	Status            string       `json:"status,omitempty"`
+	URI               string       `json:"uri,omitempty"`
+}
+
+// IsDefault reports whether the printer is the system default printer.
+func (p DataTypeItem) IsDefault() bool {
+	return normalize.Bool(p.Default)
+}
+
+// IsShared reports whether the printer is shared on the network.
+func (p DataTypeItem) IsShared() bool {
+	return normalize.Bool(p.Shared)
+}
+
+// SupportsFax reports whether the printer supports faxing.
+func (p DataTypeItem) SupportsFax() bool {
+	return normalize.Bool(p.FaxSupport)
+}
+
+// Scheme returns the URI scheme of the printer connection (e.g. "ipp", "dnssd", "usb").
+func (p DataTypeItem) Scheme() string {
+	scheme, _, found := strings.Cut(p.URI, "://")
+	if !found {
+		return ""
+	}
+	return strings.ToLower(scheme)
+}
+
+// DefaultPrinters returns the printers flagged as default. More than one
+// entry, or none while printers are configured, indicates a misconfiguration.
+func DefaultPrinters(items []DataTypeItem) []DataTypeItem {
+	var defaults []DataTypeItem
+	for _, item := range items {
+		if item.IsDefault() {
+			defaults = append(defaults, item)
+		}
+	}
+	return defaults
 }
 
 // DataType holds the parsed system profiler data for SPPrintersDataType.
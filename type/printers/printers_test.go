@@ -68,7 +68,60 @@ func TestPrintersFields(t *testing.T) {
 			t.Errorf("Printer item %d should have a name", i)
 		}
 
-		// Note: CupsVersion and Status fields are not implemented in the current DataTypeItem struct
-		// TODO: Add specific fields when implementing the full printers structure
+		t.Logf("Printer: %s (status: %s, CUPS %s)", item.Name, item.Status, item.CupsVersion)
+	}
+}
+
+func TestPrintersUnmarshal(t *testing.T) {
+	input := `[
+		{
+			"_name": "Office_LaserJet",
+			"cups filters": [
+				{"_name": "rastertohp", "filter path": "/Library/Printers/hp/filter/rastertohp", "filter version": "5.1"}
+			],
+			"cupsversion": "2.3.4",
+			"default": "yes",
+			"driverversion": "10.4",
+			"fax support": "no",
+			"ppd": "HP LaserJet Pro",
+			"shared": "yes",
+			"status": "idle",
+			"uri": "ipp://printer.local/ipp/print"
+		},
+		{
+			"_name": "Lobby_Inkjet",
+			"default": "no",
+			"shared": "no",
+			"status": "stopped",
+			"uri": "usb://Canon/Inkjet"
+		}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal printers: %v", err)
+	}
+
+	office := items[0]
+	if !office.IsDefault() || !office.IsShared() || office.SupportsFax() {
+		t.Errorf("Unexpected flags for %s: default=%v shared=%v fax=%v",
+			office.Name, office.IsDefault(), office.IsShared(), office.SupportsFax())
+	}
+	if office.CupsVersion != "2.3.4" || office.Status != "idle" {
+		t.Errorf("Expected CUPS 2.3.4 and status idle, got %q and %q", office.CupsVersion, office.Status)
+	}
+	if items[1].Status != "stopped" {
+		t.Errorf("Expected status stopped for %s, got %q", items[1].Name, items[1].Status)
+	}
+	if len(office.CupsFilters) != 1 || office.CupsFilters[0].Path == "" {
+		t.Errorf("Expected one CUPS filter with a path, got %+v", office.CupsFilters)
+	}
+	if scheme := office.Scheme(); scheme != "ipp" {
+		t.Errorf("Expected scheme ipp, got %q", scheme)
+	}
+
+	defaults := DefaultPrinters(items)
+	if len(defaults) != 1 || defaults[0].Name != "Office_LaserJet" {
+		t.Errorf("Expected Office_LaserJet as the only default printer, got %+v", defaults)
 	}
 }
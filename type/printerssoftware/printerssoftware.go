@@ -6,12 +6,49 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// PrintSoftware represents an installed print driver or printing software bundle.
+type PrintSoftware struct {
+	Name         string `json:"_name"`
+	Path         string `json:"path,omitempty"`
+	Version      string `json:"version,omitempty"`
+	ObtainedFrom string `json:"obtained_from,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPPrintersSoftwareDataType.
+// Each item is a category of print software (drivers, PPDs, plug-ins)
+// holding the bundles installed on the system.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name  string          `json:"_name"`
+	Items []PrintSoftware `json:"_items,omitempty"`
+}
+
+// OlderThan reports whether the bundle version is lower than minimum.
+// Versions are compared with normalize.Version. A bundle without a
+// readable version is not known to be older, so OlderThan returns false.
+func (s PrintSoftware) OlderThan(minimum string) bool {
+	have, err := normalize.ParseVersion(s.Version)
+	if err != nil {
+		return false
+	}
+	want, err := normalize.ParseVersion(minimum)
+	return err == nil && have.Compare(want) < 0
+}
+
+// StaleDrivers returns every bundle across items whose version is lower than
+// minimum. Bundles without a version are skipped.
+func StaleDrivers(items []DataTypeItem, minimum string) []PrintSoftware {
+	var stale []PrintSoftware
+	for _, item := range items {
+		for _, software := range item.Items {
+			if software.OlderThan(minimum) {
+				stale = append(stale, software)
+			}
+		}
+	}
+	return stale
 }
 
 // DataType holds the parsed system profiler data for SPPrintersSoftwareDataType.
@@ -25,7 +62,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPPrintersSoftwareDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPPrintersSoftwareDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize printerssoftware data: %w", err)
 			return
@@ -3,6 +3,8 @@ package printerssoftware
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
 func TestPrintersSoftwareDataType(t *testing.T) {
@@ -71,3 +73,39 @@ func TestPrintersSoftwareFields(t *testing.T) {
 		t.Logf("Printers software %d: %s", i, item.Name)
 	}
 }
+
+func TestStaleDrivers(t *testing.T) {
+	items := []DataTypeItem{
+		{
+			Name: "Printer Drivers",
+			Items: []PrintSoftware{
+				{Name: "HP Printer Driver", Version: "5.1.2"},
+				{Name: "Canon Printer Driver", Version: "10.11"},
+				{Name: "Unknown Driver"},
+			},
+		},
+	}
+
+	stale := StaleDrivers(items, "6.0")
+	if len(stale) != 1 || stale[0].Name != "HP Printer Driver" {
+		t.Fatalf("Expected only the HP driver to be stale, got %+v", stale)
+	}
+	if items[0].Items[2].OlderThan("6.0") {
+		t.Error("Expected a driver without a version not to be reported as older")
+	}
+}
+
+func TestPrintersSoftwareCategories(t *testing.T) {
+	output := `{"SPPrintersSoftwareDataType": [
+		{"_name": "Printer Drivers", "_items": [{"_name": "HP Printer Driver", "path": "/Library/Printers/hp", "version": "5.1.2"}]},
+		{"_name": "Printer PPDs", "_items": [{"_name": "HP LaserJet Pro.gz", "version": "1.0"}]}
+	]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPPrintersSoftwareDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse printers software data: %v", err)
+	}
+	if len(data.Item) != 2 || data.Item[1].Name != "Printer PPDs" || len(data.Item[0].Items) != 1 {
+		t.Errorf("Expected two categories with their bundles, got %+v", data.Item)
+	}
+}
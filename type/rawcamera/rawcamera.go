@@ -3,15 +3,43 @@ package rawcamera
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
+// SupportedCamera represents a camera model whose raw format is supported.
+type SupportedCamera struct {
+	Name string `json:"_name"`
+}
+
 // DataTypeItem represents the structure of SPRawCameraDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name    string            `json:"_name"`
+	Items   []SupportedCamera `json:"_items,omitempty"`
+	Version string            `json:"spraw_version,omitempty"`
+}
+
+// SupportedModels returns the names of every camera model with raw support.
+func SupportedModels(items []DataTypeItem) []string {
+	var models []string
+	for _, item := range items {
+		for _, camera := range item.Items {
+			models = append(models, camera.Name)
+		}
+	}
+	return models
+}
+
+// Supports reports whether raw images from the camera model are supported.
+func Supports(items []DataTypeItem, model string) bool {
+	for _, name := range SupportedModels(items) {
+		if strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(model)) {
+			return true
+		}
+	}
+	return false
 }
 
 // DataType holds the parsed system profiler data for SPRawCameraDataType.
@@ -25,7 +53,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPRawCameraDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPRawCameraDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize rawcamera data: %w", err)
 			return
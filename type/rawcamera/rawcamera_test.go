@@ -0,0 +1,35 @@
+package rawcamera
+
+import (
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+)
+
+func TestRawCameraSupport(t *testing.T) {
+	output := `{"SPRawCameraDataType": [
+		{
+			"_name": "Digital Camera RAW Support",
+			"_items": [{"_name": "Canon EOS R5"}, {"_name": "Nikon Z 8"}, {"_name": "Sony ILCE-7RM5"}],
+			"spraw_version": "15.4"
+		}
+	]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPRawCameraDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse raw camera data: %v", err)
+	}
+	if len(data.Item) != 1 || data.Item[0].Version != "15.4" {
+		t.Fatalf("Expected one entry with its version, got %+v", data.Item)
+	}
+
+	if models := SupportedModels(data.Item); len(models) != 3 || models[1] != "Nikon Z 8" {
+		t.Errorf("Unexpected supported models: %v", models)
+	}
+	if !Supports(data.Item, " nikon z 8 ") {
+		t.Error("Expected the Nikon Z 8 to be supported ignoring case and spaces")
+	}
+	if Supports(data.Item, "Leica M11") {
+		t.Error("Expected the Leica M11 not to be supported")
+	}
+}
@@ -3,15 +3,55 @@ package sas
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/samburba/go-system-profiler/v2/disk"
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// Device represents a drive attached to a SAS controller.
+type Device struct {
+	disk.Device
+	LinkSpeed  string `json:"spsas_link_speed,omitempty"`
+	MediumType string `json:"spsas_medium_type,omitempty"`
+	PortID     string `json:"spsas_port_id,omitempty"`
+	SASAddress string `json:"spsas_sas_address,omitempty"`
+	TargetID   string `json:"spsas_target_id,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPSASDataType.
+// Each item is a SAS controller and its attached drives.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name                string   `json:"_name"`
+	Items               []Device `json:"_items,omitempty"`
+	FirmwareVersion     string   `json:"spsas_firmware_version,omitempty"`
+	LinkSpeed           string   `json:"spsas_link_speed,omitempty"`
+	NegotiatedLinkSpeed string   `json:"spsas_negotiated_link_speed,omitempty"`
+	Product             string   `json:"spsas_product,omitempty"`
+	SASAddress          string   `json:"spsas_sas_address,omitempty"`
+	Vendor              string   `json:"spsas_vendor,omitempty"`
+}
+
+// IsSolidState reports whether the drive is a solid state drive.
+func (d Device) IsSolidState() bool {
+	return strings.Contains(strings.ToLower(d.MediumType), "solid_state")
+}
+
+// Speed returns the link speed of the drive, e.g. 12 Gb/s for "12 Gigabit".
+func (d Device) Speed() (normalize.Speed, error) {
+	return normalize.ParseSpeed(d.LinkSpeed)
+}
+
+// Speed returns the maximum link speed of the controller.
+func (d DataTypeItem) Speed() (normalize.Speed, error) {
+	return normalize.ParseSpeed(d.LinkSpeed)
+}
+
+// NegotiatedSpeed returns the link speed negotiated by the controller.
+func (d DataTypeItem) NegotiatedSpeed() (normalize.Speed, error) {
+	return normalize.ParseSpeed(d.NegotiatedLinkSpeed)
 }
 
 // DataType holds the parsed system profiler data for SPSASDataType.
@@ -25,7 +65,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPSASDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPSASDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize sas data: %w", err)
 			return
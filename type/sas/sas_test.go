@@ -0,0 +1,100 @@
+package sas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/disk"
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+)
+
+func TestSASDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No SAS data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestSASParse(t *testing.T) {
+	output := `{"SPSASDataType": [
+		{
+			"_name": "LSI SAS2308",
+			"_items": [
+				{
+					"_name": "SEAGATE ST4000NM0023",
+					"bsd_name": "disk2",
+					"size_in_bytes": 4000787030016,
+					"smart_status": "Verified",
+					"spsas_link_speed": "6 Gigabit",
+					"spsas_medium_type": "spsas_rotational",
+					"spsas_target_id": "0"
+				}
+			],
+			"spsas_firmware_version": "20.00.07.00",
+			"spsas_link_speed": "12 Gigabit",
+			"spsas_negotiated_link_speed": "6 Gigabit",
+			"spsas_vendor": "LSI"
+		},
+		{
+			"_name": "ATTO ExpressSAS H680",
+			"_items": [
+				{"_name": "SAMSUNG MZILT960", "bsd_name": "disk3", "spsas_medium_type": "spsas_solid_state"}
+			],
+			"spsas_vendor": "ATTO"
+		}
+	]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPSASDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse SAS data: %v", err)
+	}
+	if len(data.Item) != 2 {
+		t.Fatalf("Expected both controllers, got %d", len(data.Item))
+	}
+
+	controller := data.Item[0]
+	if controller.Name != "LSI SAS2308" || controller.Vendor != "LSI" || controller.FirmwareVersion != "20.00.07.00" {
+		t.Errorf("Controller fields not decoded: %+v", controller)
+	}
+	if speed, err := controller.Speed(); err != nil || speed.Gbps() != 12 {
+		t.Errorf("Expected a 12 Gb/s controller, got %v (%v)", speed, err)
+	}
+	if speed, err := controller.NegotiatedSpeed(); err != nil || speed.Gbps() != 6 {
+		t.Errorf("Expected a 6 Gb/s negotiated link, got %v (%v)", speed, err)
+	}
+	drive := controller.Items[0]
+	if speed, err := drive.Speed(); err != nil || speed.Gbps() != 6 {
+		t.Errorf("Expected a 6 Gb/s drive link, got %v (%v)", speed, err)
+	}
+	if drive.BsdName != "disk2" || drive.TargetID != "0" || !drive.SMARTVerified() || drive.IsSolidState() {
+		t.Errorf("Unexpected drive: %+v", drive)
+	}
+	if size, err := drive.Capacity(); err != nil || size != 4000787030016 {
+		t.Errorf("Expected the exact byte count, got %d (%v)", size, err)
+	}
+	if ssd := data.Item[1].Items[0]; ssd.BsdName != "disk3" || !ssd.IsSolidState() {
+		t.Errorf("Unexpected drive on the second controller: %+v", ssd)
+	}
+
+	// The shared drive fields can be set through the exported disk package
+	built := Device{Device: disk.Device{Name: "spare", SmartStatus: "Verified"}, TargetID: "4"}
+	if !built.SMARTVerified() {
+		t.Error("Expected a verified drive")
+	}
+}
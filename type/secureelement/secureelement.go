@@ -6,10 +6,12 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPSecureElementDataType.
 type DataTypeItem struct {
+	Name               string `json:"_name"`
 	CtlFw              string `json:"ctl_fw,omitempty"`
 	CtlHw              string `json:"ctl_hw,omitempty"`
 	CtlInfo            string `json:"ctl_info,omitempty"`
@@ -26,6 +28,76 @@ type DataTypeItem struct {
 	SeProdSigned       string `json:"se_prod_signed,omitempty"`
 }
 
+// Evaluation is the readiness assessment of a Secure Element.
+type Evaluation struct {
+	Healthy bool     `json:"healthy"`
+	Issues  []string `json:"issues,omitempty"`
+}
+
+// InRestrictedMode reports whether the Secure Element is in restricted mode.
+func (d DataTypeItem) InRestrictedMode() bool {
+	return normalize.Bool(d.SeInRestrictedMode)
+}
+
+// ProductionSigned reports whether the Secure Element runs production-signed firmware.
+func (d DataTypeItem) ProductionSigned() bool {
+	return normalize.Bool(d.SeProdSigned)
+}
+
+// FirmwareVersion returns the parsed Secure Element firmware version.
+func (d DataTypeItem) FirmwareVersion() (normalize.Version, error) {
+	return normalize.ParseVersion(d.SeFw)
+}
+
+// OSVersion returns the parsed Secure Element OS version.
+func (d DataTypeItem) OSVersion() (normalize.Version, error) {
+	return normalize.ParseVersion(d.SeOsVersion)
+}
+
+// Evaluate checks that the Secure Element is identified, production-signed
+// and not in restricted mode, which Apple Pay and Touch ID require.
+func (d DataTypeItem) Evaluate() Evaluation {
+	var issues []string
+	if d.SeID == "" {
+		issues = append(issues, "secure element ID is missing")
+	}
+	if !d.ProductionSigned() {
+		issues = append(issues, "secure element is not production signed")
+	}
+	if d.InRestrictedMode() {
+		issues = append(issues, "secure element is in restricted mode")
+	}
+	if _, err := d.OSVersion(); d.SeOsVersion != "" && err != nil {
+		issues = append(issues, fmt.Sprintf("secure element OS version is invalid: %v", err))
+	}
+	return Evaluation{Healthy: len(issues) == 0, Issues: issues}
+}
+
+// IsHealthy reports whether Evaluate found no issues.
+func (d DataTypeItem) IsHealthy() bool {
+	return d.Evaluate().Healthy
+}
+
+// Evaluate assesses every Secure Element entry. Machines may report more than
+// one entry; the result is healthy only if every entry is healthy, and each
+// issue is prefixed with the entry it belongs to.
+func Evaluate(items []DataTypeItem) Evaluation {
+	if len(items) == 0 {
+		return Evaluation{Issues: []string{"no secure element found"}}
+	}
+	var issues []string
+	for i, item := range items {
+		label := item.Name
+		if label == "" {
+			label = fmt.Sprintf("secure element %d", i)
+		}
+		for _, issue := range item.Evaluate().Issues {
+			issues = append(issues, label+": "+issue)
+		}
+	}
+	return Evaluation{Healthy: len(issues) == 0, Issues: issues}
+}
+
 // DataType holds the parsed system profiler data for SPSecureElementDataType.
 var DataType *common.DataType[DataTypeItem]
 
@@ -37,7 +109,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPSecureElementDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPSecureElementDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize secure element data: %w", err)
 			return
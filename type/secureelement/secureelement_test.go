@@ -3,6 +3,8 @@ package secureelement
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
 func TestSecureElementDataType(t *testing.T) {
@@ -75,3 +77,75 @@ func TestSecureElementFields(t *testing.T) {
 		t.Logf("Secure element device %d: %s (ID: %s)", i, item.SeDevice, item.SeID)
 	}
 }
+
+func TestSecureElementEvaluate(t *testing.T) {
+	input := `[
+		{"_name": "Secure Element", "se_id": "0A1B2C", "se_device": "SE", "se_prod_signed": "YES", "se_in_restricted_mode": "NO", "se_os_version": "2.5.1", "se_fw": "3.0"},
+		{"_name": "Secondary", "se_id": "", "se_prod_signed": "NO", "se_in_restricted_mode": "YES"}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal secure element data: %v", err)
+	}
+
+	primary := items[0]
+	if !primary.ProductionSigned() || primary.InRestrictedMode() || !primary.IsHealthy() {
+		t.Errorf("Expected a healthy primary secure element: %+v", primary.Evaluate())
+	}
+	if v, err := primary.OSVersion(); err != nil || v.Major != 2 || v.Minor != 5 || v.Patch != 1 {
+		t.Errorf("Unexpected OS version %+v (%v)", v, err)
+	}
+	if v, err := primary.FirmwareVersion(); err != nil || v.Major != 3 {
+		t.Errorf("Unexpected firmware version %+v (%v)", v, err)
+	}
+
+	secondary := items[1].Evaluate()
+	if secondary.Healthy || len(secondary.Issues) != 3 {
+		t.Errorf("Expected 3 issues for the secondary entry, got %+v", secondary)
+	}
+
+	overall := Evaluate(items)
+	if overall.Healthy || len(overall.Issues) != 3 {
+		t.Errorf("Expected the overall evaluation to carry the secondary issues, got %+v", overall)
+	}
+	if empty := Evaluate(nil); empty.Healthy {
+		t.Error("Expected no entries to be unhealthy")
+	}
+}
+
+func TestSecureElementParse(t *testing.T) {
+	output := `{"SPSecureElementDataType": [
+		{
+			"_name": "Secure Element",
+			"ctl_fw": "00.0f",
+			"ctl_hw": "0x00000001",
+			"se_device": "SE",
+			"se_fw": "SE FW 3.0",
+			"se_id": "0A1B2C3D4E5F",
+			"se_in_restricted_mode": "NO",
+			"se_os_version": "SE OS 2.5.1",
+			"se_prod_signed": "YES"
+		},
+		{"_name": "Secure Element 2", "se_id": "", "se_prod_signed": "YES", "se_in_restricted_mode": "YES"}
+	]}`
+
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPSecureElementDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse secure element data: %v", err)
+	}
+	if len(data.Item) != 2 {
+		t.Fatalf("Expected both entries, got %+v", data.Item)
+	}
+
+	if v, err := data.Item[0].OSVersion(); err != nil || v.Major != 2 || v.Minor != 5 || v.Patch != 1 {
+		t.Errorf("Unexpected OS version %+v (%v)", v, err)
+	}
+	if !data.Item[0].IsHealthy() || data.Item[1].IsHealthy() {
+		t.Error("Expected only the first entry to be healthy")
+	}
+	overall := Evaluate(data.Item)
+	if overall.Healthy || len(overall.Issues) != 2 {
+		t.Errorf("Expected two issues for the second entry, got %+v", overall)
+	}
+}
@@ -3,15 +3,56 @@ package serialata
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/samburba/go-system-profiler/v2/disk"
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// Device represents a drive attached to a Serial ATA controller.
+type Device struct {
+	disk.Device
+	MediumType      string `json:"spsata_medium_type,omitempty"`
+	NCQ             string `json:"spsata_ncq,omitempty"`
+	NCQDepth        string `json:"spsata_ncq_depth,omitempty"`
+	PortDescription string `json:"spsata_port_description,omitempty"`
+	TrimSupport     string `json:"spsata_trim_support,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPSerialATADataType.
+// Each item is a Serial ATA controller and its attached drives.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name                 string   `json:"_name"`
+	Items                []Device `json:"_items,omitempty"`
+	LinkSpeed            string   `json:"spsata_link_speed,omitempty"`
+	NegotiatedLinkSpeed  string   `json:"spsata_negotiated_link_speed,omitempty"`
+	PhysicalInterconnect string   `json:"spsata_physical_interconnect,omitempty"`
+	PortDescription      string   `json:"spsata_portdescription,omitempty"`
+	Product              string   `json:"spsata_product,omitempty"`
+	Vendor               string   `json:"spsata_vendor,omitempty"`
+}
+
+// IsSolidState reports whether the drive is a solid state drive.
+func (d Device) IsSolidState() bool {
+	return strings.Contains(strings.ToLower(d.MediumType), "solid_state")
+}
+
+// SupportsTRIM reports whether TRIM is enabled for the drive.
+func (d Device) SupportsTRIM() bool {
+	return normalize.Bool(d.TrimSupport)
+}
+
+// Speed returns the maximum link speed of the controller, e.g. 6 Gb/s for
+// "6 Gigabit".
+func (d DataTypeItem) Speed() (normalize.Speed, error) {
+	return normalize.ParseSpeed(d.LinkSpeed)
+}
+
+// NegotiatedSpeed returns the speed negotiated with the attached drive.
+func (d DataTypeItem) NegotiatedSpeed() (normalize.Speed, error) {
+	return normalize.ParseSpeed(d.NegotiatedLinkSpeed)
 }
 
 // DataType holds the parsed system profiler data for SPSerialATADataType.
@@ -25,7 +66,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPSerialATADataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPSerialATADataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize serialata data: %w", err)
 			return
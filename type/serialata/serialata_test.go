@@ -71,3 +71,52 @@ func TestSerialATAFields(t *testing.T) {
 		t.Logf("Serial ATA device %d: %s", i, item.Name)
 	}
 }
+
+func TestSerialATAUnmarshal(t *testing.T) {
+	input := `{
+		"_name": "Intel 7 Series Chipset",
+		"_items": [
+			{
+				"_name": "APPLE SSD SM256E",
+				"bsd_name": "disk0",
+				"detachable_drive": "no",
+				"device_model": "APPLE SSD SM256E",
+				"device_serial": "S0NFNYAD123456",
+				"removable_media": "no",
+				"size_in_bytes": 251000193024,
+				"smart_status": "Verified",
+				"spsata_medium_type": "spsata_solid_state",
+				"spsata_trim_support": "Yes",
+				"volumes": [{"_name": "Macintosh HD", "bsd_name": "disk0s2", "mount_point": "/"}]
+			}
+		],
+		"spsata_link_speed": "6 Gigabit",
+		"spsata_negotiated_link_speed": "6 Gigabit",
+		"spsata_vendor": "Intel"
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal Serial ATA controller: %v", err)
+	}
+
+	if len(item.Items) != 1 {
+		t.Fatalf("Expected one drive, got %d", len(item.Items))
+	}
+	if speed, err := item.NegotiatedSpeed(); err != nil || speed.Gbps() != 6 {
+		t.Errorf("Expected a 6 Gb/s link, got %v (%v)", speed, err)
+	}
+	drive := item.Items[0]
+	if drive.BsdName != "disk0" || drive.SizeInBytes != 251000193024 {
+		t.Errorf("Shared disk fields not decoded: %+v", drive.Device)
+	}
+	if !drive.SMARTVerified() || drive.IsRemovable() || drive.IsDetachable() {
+		t.Errorf("Unexpected drive flags: %+v", drive)
+	}
+	if !drive.IsSolidState() || !drive.SupportsTRIM() {
+		t.Errorf("Expected a solid state drive with TRIM: %+v", drive)
+	}
+	if len(drive.Volumes) != 1 || drive.Volumes[0].MountPoint != "/" {
+		t.Errorf("Unexpected volumes: %+v", drive.Volumes)
+	}
+}
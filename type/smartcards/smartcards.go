@@ -3,15 +3,92 @@ package smartcards
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
+// Section names reported by SPSmartCardsDataType.
+const (
+	SectionReaders           = "READERS"
+	SectionReaderDrivers     = "READERS_DRIVERS"
+	SectionSmartCardDrivers  = "SMARTCARDS_DRIVERS"
+	SectionTokenDrivers      = "TOKEN_DRIVERS"
+	SectionAvailableKeychain = "AVAILABLE_SMARTCARDS_KEYCHAIN"
+	SectionAvailableToken    = "AVAILABLE_SMARTCARDS_TOKEN"
+	SectionSmartCardPairings = "SMARTCARD_PAIRINGS"
+)
+
+// Entry represents a reader, driver, token or identity listed in a section.
+type Entry struct {
+	Name          string  `json:"_name"`
+	ATR           string  `json:"atr,omitempty"`
+	BundleID      string  `json:"bundle_id,omitempty"`
+	Certificates  []Entry `json:"certificates,omitempty"`
+	Driver        string  `json:"driver,omitempty"`
+	Path          string  `json:"path,omitempty"`
+	PublicKeyHash string  `json:"public_key_hash,omitempty"`
+	ReaderName    string  `json:"reader_name,omitempty"`
+	State         string  `json:"state,omitempty"`
+	TokenID       string  `json:"token_id,omitempty"`
+	User          string  `json:"user,omitempty"`
+	Version       string  `json:"version,omitempty"`
+}
+
 // DataTypeItem represents the structure of SPSmartCardsDataType.
+// Each item is a section (readers, drivers, tokens, pairings) holding its entries.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name  string  `json:"_name"`
+	Items []Entry `json:"_items,omitempty"`
+}
+
+// HasCard reports whether a token is inserted into the reader.
+func (e Entry) HasCard() bool {
+	return e.ATR != "" || strings.EqualFold(e.State, "present") || strings.EqualFold(e.State, "inserted")
+}
+
+// Section returns the entries of the named section.
+func Section(items []DataTypeItem, name string) []Entry {
+	for _, item := range items {
+		if strings.EqualFold(item.Name, name) {
+			return item.Items
+		}
+	}
+	return nil
+}
+
+// Readers returns the connected smart card readers.
+func Readers(items []DataTypeItem) []Entry {
+	return Section(items, SectionReaders)
+}
+
+// TokenDrivers returns the installed CryptoTokenKit token driver extensions.
+func TokenDrivers(items []DataTypeItem) []Entry {
+	return Section(items, SectionTokenDrivers)
+}
+
+// Identities returns the smart card identities available through the
+// keychain and token interfaces.
+func Identities(items []DataTypeItem) []Entry {
+	identities := append([]Entry{}, Section(items, SectionAvailableKeychain)...)
+	return append(identities, Section(items, SectionAvailableToken)...)
+}
+
+// Pairings returns the smart card identities paired with user accounts.
+func Pairings(items []DataTypeItem) []Entry {
+	return Section(items, SectionSmartCardPairings)
+}
+
+// HasTokenDriver reports whether a token driver with the given bundle
+// identifier is installed.
+func HasTokenDriver(items []DataTypeItem, bundleID string) bool {
+	for _, driver := range TokenDrivers(items) {
+		if strings.EqualFold(driver.BundleID, bundleID) || strings.EqualFold(driver.Name, bundleID) {
+			return true
+		}
+	}
+	return false
 }
 
 // DataType holds the parsed system profiler data for SPSmartCardsDataType.
@@ -25,7 +102,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPSmartCardsDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPSmartCardsDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize smartcards data: %w", err)
 			return
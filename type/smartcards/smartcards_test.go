@@ -3,6 +3,8 @@ package smartcards
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
 func TestSmartCardsDataType(t *testing.T) {
@@ -69,3 +71,47 @@ func TestSmartCardsFields(t *testing.T) {
 		}
 	}
 }
+
+func TestSmartCardsSections(t *testing.T) {
+	output := `{"SPSmartCardsDataType": [
+		{"_name": "READERS", "_items": [
+			{"_name": "#01", "reader_name": "Yubico YubiKey OTP+FIDO+CCID", "atr": "3b:fd:13:00"}
+		]},
+		{"_name": "TOKEN_DRIVERS", "_items": [
+			{"_name": "com.apple.CryptoTokenKit.pivtoken", "bundle_id": "com.apple.CryptoTokenKit.pivtoken", "version": "1.0", "path": "/System/Library/Frameworks/CryptoTokenKit.framework/PlugIns/pivtoken.appex"}
+		]},
+		{"_name": "AVAILABLE_SMARTCARDS_TOKEN", "_items": [
+			{"_name": "com.apple.pivtoken:1234", "token_id": "1234", "certificates": [{"_name": "Certificate For PIV Authentication"}]}
+		]},
+		{"_name": "SMARTCARD_PAIRINGS", "_items": [
+			{"_name": "alice", "user": "alice", "public_key_hash": "A1B2C3"}
+		]}
+	]}`
+
+	// Every section is a top-level entry of the data type
+	data, err := common.ParseEntriesData[DataTypeItem](common.SPSmartCardsDataType, []byte(output))
+	if err != nil {
+		t.Fatalf("Failed to parse smart cards data: %v", err)
+	}
+	items := data.Item
+	if len(items) != 4 {
+		t.Fatalf("Expected four sections, got %d", len(items))
+	}
+
+	readers := Readers(items)
+	if len(readers) != 1 || !readers[0].HasCard() {
+		t.Errorf("Expected one reader with an inserted card, got %+v", readers)
+	}
+	if !HasTokenDriver(items, "com.apple.CryptoTokenKit.pivtoken") {
+		t.Error("Expected the PIV token driver to be installed")
+	}
+	if HasTokenDriver(items, "com.example.missing") {
+		t.Error("Unexpected token driver reported")
+	}
+	if identities := Identities(items); len(identities) != 1 || len(identities[0].Certificates) != 1 {
+		t.Errorf("Unexpected identities: %+v", identities)
+	}
+	if pairings := Pairings(items); len(pairings) != 1 || pairings[0].User != "alice" {
+		t.Errorf("Unexpected pairings: %+v", pairings)
+	}
+}
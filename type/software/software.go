@@ -3,9 +3,12 @@ package software
 
 import (
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // DataTypeItem represents the structure of SPSoftwareDataType.
@@ -22,6 +25,79 @@ type DataTypeItem struct {
 	UserName        string `json:"user_name,omitempty"`
 }
 
+// OSVersion represents a parsed operating system version such as
+// "macOS 14.5 (23F79)" or "macOS 13.4.1 (a) (22F770820d)". Compare and
+// AtLeast come from the embedded normalize.Version.
+type OSVersion struct {
+	Name string `json:"name"`
+	normalize.Version
+}
+
+// String formats the version the way system_profiler does.
+func (v OSVersion) String() string {
+	return strings.TrimSpace(v.Name + " " + v.Version.String())
+}
+
+// AtLeastRelease reports whether v is the same as or newer than
+// major.minor.patch.
+func (v OSVersion) AtLeastRelease(major, minor, patch int) bool {
+	return v.Version.Compare(normalize.Version{Major: major, Minor: minor, Patch: patch}) >= 0
+}
+
+// ParseOSVersion parses an os_version value.
+func ParseOSVersion(value string) (OSVersion, error) {
+	name, v, err := normalize.ParseNamedVersion(value)
+	if err != nil {
+		return OSVersion{}, err
+	}
+	return OSVersion{Name: name, Version: v}, nil
+}
+
+// ParseUptime parses uptime values such as "up 3:02:05:33" (days, hours,
+// minutes, seconds), "3 days, 2:05" or "2:05".
+func ParseUptime(value string) (time.Duration, error) {
+	return normalize.ParseDuration(value)
+}
+
+// ParsedOSVersion returns the parsed os_version.
+func (d DataTypeItem) ParsedOSVersion() (OSVersion, error) {
+	return ParseOSVersion(d.OsVersion)
+}
+
+// UptimeDuration returns the parsed uptime.
+func (d DataTypeItem) UptimeDuration() (time.Duration, error) {
+	return ParseUptime(d.Uptime)
+}
+
+// BootTime returns the approximate time the system booted, derived from the uptime.
+func (d DataTypeItem) BootTime() (time.Time, error) {
+	return d.bootTime(time.Now())
+}
+
+// bootTime computes the boot time relative to now.
+func (d DataTypeItem) bootTime(now time.Time) (time.Time, error) {
+	uptime, err := d.UptimeDuration()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return now.Add(-uptime).Truncate(time.Second), nil
+}
+
+// SIPEnabled reports whether System Integrity Protection is enabled.
+func (d DataTypeItem) SIPEnabled() bool {
+	return normalize.Bool(d.SystemIntegrity)
+}
+
+// SecureVMEnabled reports whether secure virtual memory is enabled.
+func (d DataTypeItem) SecureVMEnabled() bool {
+	return normalize.Bool(d.SecureVM)
+}
+
+// SafeBoot reports whether the system was started in safe mode.
+func (d DataTypeItem) SafeBoot() bool {
+	return strings.Contains(strings.ToLower(d.BootMode), "safe")
+}
+
 // DataType holds the parsed system profiler data for SPSoftwareDataType.
 var DataType *common.DataType[DataTypeItem]
 
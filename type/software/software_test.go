@@ -3,6 +3,9 @@ package software
 import (
 	"encoding/json"
 	"testing"
+	"time"
+
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 func TestSoftwareDataType(t *testing.T) {
@@ -75,3 +78,79 @@ func TestSoftwareFields(t *testing.T) {
 		t.Error("Name should not be empty")
 	}
 }
+
+func TestSoftwareParsedFields(t *testing.T) {
+	input := `{
+		"_name": "os_overview",
+		"boot_mode": "normal_boot",
+		"os_version": "macOS 13.4.1 (a) (22F770820d)",
+		"secure_vm": "secure_vm_enabled",
+		"system_integrity": "integrity_enabled",
+		"uptime": "up 3:02:05:33"
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal software data: %v", err)
+	}
+
+	version, err := item.ParsedOSVersion()
+	if err != nil {
+		t.Fatalf("Failed to parse OS version: %v", err)
+	}
+	want := OSVersion{Name: "macOS", Version: normalize.Version{Major: 13, Minor: 4, Patch: 1, Build: "22F770820d", RapidSecurityResponse: "a"}}
+	if version != want {
+		t.Errorf("ParsedOSVersion() = %+v, want %+v", version, want)
+	}
+	if version.String() != item.OsVersion {
+		t.Errorf("String() = %q, want %q", version.String(), item.OsVersion)
+	}
+	if !version.AtLeastRelease(13, 4, 1) || version.AtLeastRelease(14, 0, 0) {
+		t.Error("Unexpected AtLeastRelease result")
+	}
+	if ok, err := version.AtLeast("13.4.1 (a)"); err != nil || !ok {
+		t.Errorf("AtLeast(13.4.1 (a)) = %v (%v), want true", ok, err)
+	}
+
+	uptime, err := item.UptimeDuration()
+	if err != nil {
+		t.Fatalf("Failed to parse uptime: %v", err)
+	}
+	if want := 3*24*time.Hour + 2*time.Hour + 5*time.Minute + 33*time.Second; uptime != want {
+		t.Errorf("UptimeDuration() = %v, want %v", uptime, want)
+	}
+
+	now := time.Date(2024, 6, 4, 12, 0, 0, 0, time.UTC)
+	boot, err := item.bootTime(now)
+	if err != nil || !boot.Equal(now.Add(-uptime)) {
+		t.Errorf("Unexpected boot time %v (%v)", boot, err)
+	}
+
+	if !item.SIPEnabled() || !item.SecureVMEnabled() || item.SafeBoot() {
+		t.Errorf("Unexpected security state: sip=%v securevm=%v safeboot=%v", item.SIPEnabled(), item.SecureVMEnabled(), item.SafeBoot())
+	}
+}
+
+func TestParseOSVersionAndUptime(t *testing.T) {
+	version, err := ParseOSVersion("macOS 14.5 (23F79)")
+	if err != nil || version.Major != 14 || version.Minor != 5 || version.Build != "23F79" || version.RapidSecurityResponse != "" {
+		t.Errorf("Unexpected version %+v (%v)", version, err)
+	}
+	if _, err := ParseOSVersion("macOS"); err == nil {
+		t.Error("Expected an error for a missing version number")
+	}
+
+	uptimes := map[string]time.Duration{
+		"3 days, 2:05": 3*24*time.Hour + 2*time.Hour + 5*time.Minute,
+		"1 day, 0:01":  24*time.Hour + time.Minute,
+		"2:05":         2*time.Hour + 5*time.Minute,
+	}
+	for input, want := range uptimes {
+		if got, err := ParseUptime(input); err != nil || got != want {
+			t.Errorf("ParseUptime(%q) = %v (%v), want %v", input, got, err, want)
+		}
+	}
+	if _, err := ParseUptime("soon"); err == nil {
+		t.Error("Expected an error for an invalid uptime")
+	}
+}
@@ -3,15 +3,83 @@ package spi
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
 )
 
-// DataTypeItem represents the structure of SPSPIDataType.
-type DataTypeItem struct {
+// Properties are the fields system_profiler reports for every SPI device,
+// whether it is a top level entry or one of its children.
+type Properties struct {
+	ProductID       string `json:"a_product_id,omitempty"`
+	VendorID        string `json:"b_vendor_id,omitempty"`
+	FirmwareVersion string `json:"c_stfw_version,omitempty"`
+	SerialNumber    string `json:"d_serial_num,omitempty"`
+	LocationID      string `json:"e_location_id,omitempty"`
+	Manufacturer    string `json:"f_manufacturer,omitempty"`
+	Version         string `json:"g_version,omitempty"`
+	CountryCode     string `json:"h_country_code,omitempty"`
+}
+
+// Device represents an input device attached over SPI, such as the
+// internal keyboard or trackpad.
+type Device struct {
 	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Properties
+}
+
+// DataTypeItem represents the structure of SPSPIDataType. Each item is an
+// SPI device and the devices it exposes, e.g. its keyboard and trackpad.
+type DataTypeItem struct {
+	Name  string   `json:"_name"`
+	Items []Device `json:"_items,omitempty"`
+	Properties
+}
+
+// Device returns the item without its children.
+func (d DataTypeItem) Device() Device {
+	return Device{Name: d.Name, Properties: d.Properties}
+}
+
+// VendorIDValue returns the numeric USB-style vendor ID, e.g. 0x05ac for Apple.
+func (p Properties) VendorIDValue() (uint64, error) {
+	return parseHexID(p.VendorID)
+}
+
+// ProductIDValue returns the numeric product ID.
+func (p Properties) ProductIDValue() (uint64, error) {
+	return parseHexID(p.ProductID)
+}
+
+// IsKeyboard reports whether the device is a keyboard.
+func (d Device) IsKeyboard() bool {
+	return strings.Contains(strings.ToLower(d.Name), "keyboard")
+}
+
+// IsTrackpad reports whether the device is a trackpad.
+func (d Device) IsTrackpad() bool {
+	return strings.Contains(strings.ToLower(d.Name), "trackpad")
+}
+
+// Devices returns the top level devices and all of their children.
+func Devices(items []DataTypeItem) []Device {
+	var devices []Device
+	for _, item := range items {
+		devices = append(devices, item.Device())
+		devices = append(devices, item.Items...)
+	}
+	return devices
+}
+
+// parseHexID parses values such as "0x05ac" or "0x05ac  (Apple Inc.)".
+func parseHexID(value string) (uint64, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty ID")
+	}
+	return strconv.ParseUint(strings.TrimPrefix(strings.ToLower(fields[0]), "0x"), 16, 32)
 }
 
 // DataType holds the parsed system profiler data for SPSPIDataType.
@@ -25,7 +93,7 @@ var (
 // Initialize ensures the DataType is initialized exactly once
 func Initialize() error {
 	initOnce.Do(func() {
-		data, err := common.NewData[DataTypeItem](common.SPSPIDataType)
+		data, err := common.NewEntriesData[DataTypeItem](common.SPSPIDataType)
 		if err != nil {
 			initErr = fmt.Errorf("failed to initialize spi data: %w", err)
 			return
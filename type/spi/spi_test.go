@@ -62,7 +62,52 @@ func TestSPIFields(t *testing.T) {
 			t.Errorf("SPI item %d should have a name", i)
 		}
 
-		// Note: FManufacturer field is not implemented in the current DataTypeItem struct
-		// TODO: Add specific fields when implementing the full SPI structure
+		t.Logf("SPI device %d: %s (manufacturer: %s)", i, item.Name, item.Manufacturer)
+	}
+}
+
+func TestSPIDevices(t *testing.T) {
+	input := `[
+		{
+			"_name": "Apple Internal Keyboard / Trackpad",
+			"a_product_id": "0x0343",
+			"b_vendor_id": "0x05ac  (Apple Inc.)",
+			"c_stfw_version": "5.54",
+			"f_manufacturer": "Apple Inc.",
+			"_items": [
+				{"_name": "Keyboard", "h_country_code": "33"},
+				{"_name": "Trackpad"}
+			]
+		}
+	]`
+
+	var items []DataTypeItem
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		t.Fatalf("Failed to unmarshal SPI data: %v", err)
+	}
+
+	vendor, err := items[0].VendorIDValue()
+	if err != nil || vendor != 0x05ac {
+		t.Errorf("Expected Apple vendor ID, got %#x (%v)", vendor, err)
+	}
+	product, err := items[0].ProductIDValue()
+	if err != nil || product != 0x0343 {
+		t.Errorf("Expected product ID 0x0343, got %#x (%v)", product, err)
+	}
+
+	devices := Devices(items)
+	if len(devices) != 3 {
+		t.Fatalf("Expected 3 devices, got %d", len(devices))
+	}
+	if !devices[1].IsKeyboard() || devices[1].CountryCode != "33" || !devices[2].IsTrackpad() {
+		t.Errorf("Unexpected child devices: %+v", devices[1:])
+	}
+	if devices[0].Name != items[0].Name || devices[0].FirmwareVersion != "5.54" {
+		t.Errorf("Unexpected top level device: %+v", devices[0])
+	}
+
+	literal := DataTypeItem{Name: "Apple Internal Keyboard", Properties: Properties{VendorID: "0x05ac"}}
+	if vendor, err := literal.VendorIDValue(); err != nil || vendor != 0x05ac || !literal.Device().IsKeyboard() {
+		t.Errorf("Unexpected literal item: %+v", literal)
 	}
 }
@@ -5,12 +5,76 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/samburba/go-system-profiler/v2/disk"
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
-// DataTypeItem represents the structure of SPStorageDataType.
+// PhysicalDrive represents the drive a volume is stored on.
+type PhysicalDrive struct {
+	DeviceName       string `json:"device_name,omitempty"`
+	IsInternalDisk   string `json:"is_internal_disk,omitempty"`
+	MediaName        string `json:"media_name,omitempty"`
+	MediumType       string `json:"medium_type,omitempty"`
+	PartitionMapType string `json:"partition_map_type,omitempty"`
+	Protocol         string `json:"protocol,omitempty"`
+	SmartStatus      string `json:"smart_status,omitempty"`
+}
+
+// DataTypeItem represents the structure of SPStorageDataType. Each item is a
+// mounted volume.
 type DataTypeItem struct {
-	Name string `json:"_name"`
+	Name             string         `json:"_name"`
+	BsdName          string         `json:"bsd_name,omitempty"`
+	FileSystem       string         `json:"file_system,omitempty"`
+	FreeSpace        string         `json:"free_space,omitempty"`
+	FreeSpaceInBytes int64          `json:"free_space_in_bytes,omitempty"`
+	IgnoreOwnership  string         `json:"ignore_ownership,omitempty"`
+	IOContent        string         `json:"iocontent,omitempty"`
+	MountPoint       string         `json:"mount_point,omitempty"`
+	PhysicalDrive    *PhysicalDrive `json:"physical_drive,omitempty"`
+	Size             string         `json:"size,omitempty"`
+	SizeInBytes      int64          `json:"size_in_bytes,omitempty"`
+	VolumeUUID       string         `json:"volume_uuid,omitempty"`
+	Writable         string         `json:"writable,omitempty"`
+}
+
+// Volume returns the item as a disk.Volume, the shape volumes have on every
+// storage bus.
+func (d DataTypeItem) Volume() disk.Volume {
+	return disk.Volume{
+		Name:             d.Name,
+		BsdName:          d.BsdName,
+		FileSystem:       d.FileSystem,
+		FreeSpace:        d.FreeSpace,
+		FreeSpaceInBytes: d.FreeSpaceInBytes,
+		IOContent:        d.IOContent,
+		MountPoint:       d.MountPoint,
+		Size:             d.Size,
+		SizeInBytes:      d.SizeInBytes,
+		VolumeUUID:       d.VolumeUUID,
+		Writable:         d.Writable,
+	}
+}
+
+// Capacity returns the volume size, preferring the exact byte count.
+func (d DataTypeItem) Capacity() (normalize.ByteSize, error) {
+	return d.Volume().Capacity()
+}
+
+// Free returns the volume free space, preferring the exact byte count.
+func (d DataTypeItem) Free() (normalize.ByteSize, error) {
+	return d.Volume().Free()
+}
+
+// IsInternal reports whether the volume is stored on an internal drive.
+func (d DataTypeItem) IsInternal() bool {
+	return d.PhysicalDrive != nil && normalize.Bool(d.PhysicalDrive.IsInternalDisk)
+}
+
+// IsWritable reports whether the volume is mounted read-write.
+func (d DataTypeItem) IsWritable() bool {
+	return normalize.Bool(d.Writable)
 }
 
 // DataType holds the parsed system profiler data for SPStorageDataType.
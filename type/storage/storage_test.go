@@ -64,3 +64,34 @@ func TestStorageFields(t *testing.T) {
 	// Log storage information
 	t.Logf("Storage data structure test passed")
 }
+
+func TestStorageVolume(t *testing.T) {
+	jsonData := []byte(`{
+		"_name": "Macintosh HD - Data",
+		"bsd_name": "disk3s5",
+		"file_system": "APFS",
+		"free_space_in_bytes": 123456789012,
+		"mount_point": "/System/Volumes/Data",
+		"size_in_bytes": 494384795648,
+		"writable": "yes",
+		"physical_drive": {"device_name": "APPLE SSD AP0512Z", "is_internal_disk": "yes", "medium_type": "ssd"}
+	}`)
+	var item DataTypeItem
+	if err := json.Unmarshal(jsonData, &item); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if item.Name != "Macintosh HD - Data" || !item.IsInternal() || !item.IsWritable() {
+		t.Errorf("Unexpected volume: %+v", item)
+	}
+	if free, err := item.Free(); err != nil || free.Bytes() != 123456789012 {
+		t.Errorf("Free() = %v (%v)", free, err)
+	}
+	if v := item.Volume(); v.Name != item.Name || v.MountPoint != "/System/Volumes/Data" {
+		t.Errorf("Unexpected disk volume: %+v", v)
+	}
+
+	literal := DataTypeItem{Name: "Backup", MountPoint: "/Volumes/Backup"}
+	if literal.IsInternal() {
+		t.Error("Expected a volume without a physical drive not to be internal")
+	}
+}
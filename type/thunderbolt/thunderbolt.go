@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
 // ReceptacleTag represents Thunderbolt receptacle information.
@@ -16,6 +17,11 @@ type ReceptacleTag struct {
 	ReceptacleStatusKey string `json:"receptacle_status_key,omitempty"`
 }
 
+// CurrentSpeed returns the parsed link speed, e.g. 40 Gb/s for "Up to 40 Gb/s".
+func (r ReceptacleTag) CurrentSpeed() (normalize.Speed, error) {
+	return normalize.ParseSpeed(r.CurrentSpeedKey)
+}
+
 // DataTypeItem represents the structure of SPThunderboltDataType.
 type DataTypeItem struct {
 	Name           string        `json:"_name"`
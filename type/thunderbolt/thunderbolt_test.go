@@ -71,3 +71,11 @@ func TestThunderboltFields(t *testing.T) {
 		t.Logf("Thunderbolt device %d: %s", i, item.Name)
 	}
 }
+
+func TestThunderboltCurrentSpeed(t *testing.T) {
+	receptacle := ReceptacleTag{CurrentSpeedKey: "Up to 40 Gb/s"}
+	speed, err := receptacle.CurrentSpeed()
+	if err != nil || speed.Gbps() != 40 {
+		t.Errorf("Expected 40 Gb/s, got %v (%v)", speed, err)
+	}
+}
@@ -2,16 +2,152 @@
 package universalaccess
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/normalize"
 )
 
+// State is an accessibility setting decoded from system_profiler's
+// "spua_<feature>_on"/"spua_<feature>_off" style values.
+type State int
+
+// Accessibility setting states. StateUnknown is used for missing settings and
+// for values that are not recognized.
+const (
+	StateUnknown State = iota
+	StateOff
+	StateOn
+)
+
+// Enabled reports whether the setting is on.
+func (s State) Enabled() bool {
+	return s == StateOn
+}
+
+// String returns "on", "off" or "unknown".
+func (s State) String() string {
+	switch s {
+	case StateOn:
+		return normalize.On
+	case StateOff:
+		return normalize.Off
+	}
+	return normalize.Unknown
+}
+
+// MarshalJSON encodes the state as a boolean, or null when it is unknown.
+func (s State) MarshalJSON() ([]byte, error) {
+	switch s {
+	case StateOn:
+		return []byte("true"), nil
+	case StateOff:
+		return []byte("false"), nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON decodes booleans, numbers and on/off style strings.
+func (s *State) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch v := raw.(type) {
+	case bool:
+		*s = stateOf(v)
+	case float64:
+		*s = stateOf(v != 0)
+	case string:
+		*s = parseState(v)
+	case nil:
+		*s = StateUnknown
+	default:
+		return fmt.Errorf("unsupported universal access value %s", data)
+	}
+	return nil
+}
+
 // DataTypeItem represents the structure of SPUniversalAccessDataType.
 type DataTypeItem struct {
-	Name string `json:"_name"`
-	// TODO: Add specific fields based on system_profiler output
+	Name                string `json:"_name"`
+	Contrast            State  `json:"contrast,omitempty"`
+	CursorMagnification State  `json:"cursor_mag,omitempty"`
+	Display             string `json:"display,omitempty"`
+	FlashScreen         State  `json:"flash_screen,omitempty"`
+	KeyboardZoom        State  `json:"keyboardZoom,omitempty"`
+	MouseKeys           State  `json:"mouse_keys,omitempty"`
+	ScrollZoom          State  `json:"scrollZoom,omitempty"`
+	SlowKeys            State  `json:"slow_keys,omitempty"`
+	StickyKeys          State  `json:"sticky_keys,omitempty"`
+	VoiceOver           State  `json:"voiceover,omitempty"`
+	Zoom                State  `json:"zoom_mode,omitempty"`
+}
+
+// InvertedDisplay reports whether the display colors are inverted or grayscale.
+func (d DataTypeItem) InvertedDisplay() bool {
+	display := strings.ToLower(d.Display)
+	return strings.Contains(display, "reverse") || strings.Contains(display, "invert") || strings.Contains(display, "gray")
+}
+
+// EnabledFeatures returns the names of the assistive features that are on.
+func (d DataTypeItem) EnabledFeatures() []string {
+	features := []struct {
+		name    string
+		enabled State
+	}{
+		{"voiceover", d.VoiceOver},
+		{"zoom", stateOf(d.Zoom.Enabled() || d.KeyboardZoom.Enabled() || d.ScrollZoom.Enabled())},
+		{"contrast", d.Contrast},
+		{"cursor_magnification", d.CursorMagnification},
+		{"flash_screen", d.FlashScreen},
+		{"sticky_keys", d.StickyKeys},
+		{"slow_keys", d.SlowKeys},
+		{"mouse_keys", d.MouseKeys},
+	}
+	var enabled []string
+	for _, feature := range features {
+		if feature.enabled.Enabled() {
+			enabled = append(enabled, feature.name)
+		}
+	}
+	if d.InvertedDisplay() {
+		enabled = append(enabled, "display")
+	}
+	return enabled
+}
+
+// UsesAssistiveFeatures reports whether any assistive feature is enabled.
+func (d DataTypeItem) UsesAssistiveFeatures() bool {
+	return len(d.EnabledFeatures()) > 0
+}
+
+// stateOf converts a boolean to StateOn or StateOff
+func stateOf(on bool) State {
+	if on {
+		return StateOn
+	}
+	return StateOff
+}
+
+// parseState interprets values such as "spua_voiceover_on", "on", "normal",
+// "0" or localized on/off strings. Other values are StateUnknown.
+func parseState(value string) State {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		return stateOf(n != 0)
+	}
+	if b, err := normalize.ParseBool(value); err == nil {
+		return stateOf(b)
+	}
+	if value == "normal" || strings.HasSuffix(value, "_normal") {
+		return StateOff
+	}
+	return StateUnknown
 }
 
 // DataType holds the parsed system profiler data for SPUniversalAccessDataType.
@@ -0,0 +1,83 @@
+package universalaccess
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUniversalAccessDataType(t *testing.T) {
+	// Initialize the DataType
+	if err := Initialize(); err != nil {
+		t.Skipf("Skipping: Failed to initialize DataType: %v", err)
+	}
+
+	// Test that DataType is not nil
+	if DataType == nil {
+		t.Skip("Skipping: No universal access data available")
+	}
+
+	// Test JSON marshaling
+	jsonData, err := json.Marshal(DataType)
+	if err != nil {
+		t.Errorf("Failed to marshal DataType to JSON: %v", err)
+	}
+
+	// Test that JSON is not empty
+	if len(jsonData) == 0 {
+		t.Error("JSON data should not be empty")
+	}
+}
+
+func TestUniversalAccessStates(t *testing.T) {
+	input := `{
+		"_name": "universalaccess",
+		"contrast": "0.2",
+		"cursor_mag": "normal",
+		"display": "spua_display_normal",
+		"flash_screen": "spua_flash_screen_off",
+		"mouse_keys": "spua_mouse_keys_off",
+		"slow_keys": "spua_slow_keys_off",
+		"sticky_keys": "spua_sticky_keys_on",
+		"voiceover": "spua_voiceover_on",
+		"zoom_mode": "spua_zoom_mode_off",
+		"keyboardZoom": "spua_keyboard_zoom_sideways"
+	}`
+
+	var item DataTypeItem
+	if err := json.Unmarshal([]byte(input), &item); err != nil {
+		t.Fatalf("Failed to unmarshal universal access data: %v", err)
+	}
+
+	if !item.VoiceOver.Enabled() || !item.StickyKeys.Enabled() || !item.Contrast.Enabled() {
+		t.Errorf("Expected VoiceOver, sticky keys and contrast on: %+v", item)
+	}
+	for _, state := range []State{item.Zoom, item.SlowKeys, item.MouseKeys, item.CursorMagnification} {
+		if state != StateOff {
+			t.Errorf("Expected remaining features off: %+v", item)
+		}
+	}
+	if item.KeyboardZoom != StateUnknown || item.ScrollZoom != StateUnknown {
+		t.Errorf("Expected unrecognized and missing values to be unknown, got %s and %s", item.KeyboardZoom, item.ScrollZoom)
+	}
+	if item.InvertedDisplay() {
+		t.Errorf("Expected normal display, got %q", item.Display)
+	}
+
+	features := item.EnabledFeatures()
+	if len(features) != 3 || features[0] != "voiceover" {
+		t.Errorf("Unexpected enabled features: %v", features)
+	}
+
+	// Typed states survive a JSON round trip
+	data, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Failed to marshal item: %v", err)
+	}
+	var decoded DataTypeItem
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal round-tripped item: %v", err)
+	}
+	if decoded != item {
+		t.Errorf("Round trip mismatch: %+v != %+v", decoded, item)
+	}
+}
@@ -0,0 +1,146 @@
+// Package watch polls system profiler data types and reports what changed
+// between two runs, e.g. a USB device being attached, a display being
+// connected or the network service order changing.
+package watch
+
+import (
+	"context"
+	"time"
+
+	"github.com/samburba/go-system-profiler/v2/diff"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// Default polling settings.
+const (
+	DefaultInterval = 30 * time.Second
+	maxBackoff      = 10
+)
+
+// Event is a change between two polls, or a failed poll when Err is set.
+type Event struct {
+	Time time.Time
+	diff.Change
+	Err error
+}
+
+// Options controls a Watcher.
+type Options struct {
+	// Types lists the data types to poll. Polling every data type takes
+	// minutes, so callers should name the few they need.
+	Types []snapshot.SPDataType
+	// Interval is the time between two polls. It defaults to DefaultInterval.
+	Interval time.Duration
+	// MaxInterval caps the interval when polls are slow or failing. It
+	// defaults to ten times Interval.
+	MaxInterval time.Duration
+	// Snapshot controls how system_profiler is invoked.
+	Snapshot snapshot.Options
+	// Ignore lists fields that are not reported as modified. It defaults to
+	// diff.DefaultIgnore; use an empty, non-nil slice to report every field.
+	Ignore []string
+	// Collect replaces the call to snapshot.Collect, e.g. to replay saved
+	// snapshots.
+	Collect func(ctx context.Context, opts snapshot.Options, types ...snapshot.SPDataType) (*snapshot.Snapshot, error)
+}
+
+// Watcher polls data types and sends change events.
+type Watcher struct {
+	opts Options
+}
+
+// New returns a Watcher for the given options.
+func New(opts Options) *Watcher {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.MaxInterval < opts.Interval {
+		opts.MaxInterval = opts.Interval * maxBackoff
+	}
+	if opts.Ignore == nil {
+		opts.Ignore = diff.DefaultIgnore
+	}
+	if opts.Collect == nil {
+		opts.Collect = snapshot.Collect
+	}
+	return &Watcher{opts: opts}
+}
+
+// Watch starts polling and returns the channel events are sent on. The first
+// poll only records the initial state. The channel is closed once ctx is
+// done.
+//
+// When a poll takes more than half the interval or fails, the interval is
+// doubled, up to MaxInterval, so a slow system_profiler is not started again
+// right away. It is reset after the next fast, successful poll.
+func (w *Watcher) Watch(ctx context.Context) <-chan Event {
+	events := make(chan Event)
+	go w.run(ctx, events)
+	return events
+}
+
+// run is the polling loop of Watch
+func (w *Watcher) run(ctx context.Context, events chan<- Event) {
+	defer close(events)
+
+	var previous *snapshot.Snapshot
+	interval := w.opts.Interval
+	for {
+		start := time.Now()
+		current, err := w.opts.Collect(ctx, w.opts.Snapshot, w.opts.Types...)
+		elapsed := time.Since(start)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil && previous != nil {
+			var report *diff.Report
+			report, err = diff.Snapshots(previous, current, diff.Options{Ignore: w.opts.Ignore})
+			if err == nil {
+				for _, change := range report.Changes {
+					if !send(ctx, events, Event{Time: start, Change: change}) {
+						return
+					}
+				}
+			}
+		}
+		if err != nil {
+			if !send(ctx, events, Event{Time: start, Err: err}) {
+				return
+			}
+		} else {
+			previous = current
+		}
+
+		interval = w.next(interval, elapsed, err)
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// next returns the interval to wait before the next poll
+func (w *Watcher) next(interval, elapsed time.Duration, err error) time.Duration {
+	if err == nil && elapsed <= w.opts.Interval/2 {
+		return w.opts.Interval
+	}
+	interval *= 2
+	if interval > w.opts.MaxInterval {
+		interval = w.opts.MaxInterval
+	}
+	return interval
+}
+
+// send delivers an event unless ctx is done first
+func send(ctx context.Context, events chan<- Event, e Event) bool {
+	select {
+	case events <- e:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,75 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/samburba/go-system-profiler/v2/diff"
+	"github.com/samburba/go-system-profiler/v2/internal/common"
+	"github.com/samburba/go-system-profiler/v2/snapshot"
+)
+
+// replay returns a collector that returns the given outputs in order and
+// then repeats the last one
+func replay(t *testing.T, outputs ...string) func(context.Context, snapshot.Options, ...snapshot.SPDataType) (*snapshot.Snapshot, error) {
+	calls := 0
+	return func(context.Context, snapshot.Options, ...snapshot.SPDataType) (*snapshot.Snapshot, error) {
+		output := outputs[len(outputs)-1]
+		if calls < len(outputs) {
+			output = outputs[calls]
+		}
+		calls++
+		if output == "" {
+			return nil, errors.New("system_profiler failed")
+		}
+		s, err := snapshot.Parse([]byte(output))
+		if err != nil {
+			t.Errorf("Failed to parse fixture: %v", err)
+		}
+		return s, err
+	}
+}
+
+func TestWatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	w := New(Options{
+		Types:    []snapshot.SPDataType{common.SPUSBDataType},
+		Interval: time.Millisecond,
+		Collect: replay(t,
+			`{"SPUSBDataType": [{"_name": "USB31Bus", "_items": [{"_name": "Hub", "serial_num": "H1"}]}]}`,
+			"",
+			`{"SPUSBDataType": [{"_name": "USB31Bus", "_items": [{"_name": "Hub", "serial_num": "H1"}, {"_name": "YubiKey", "serial_num": "Y1"}]}]}`,
+		),
+	})
+	events := w.Watch(ctx)
+
+	first := <-events
+	if first.Err == nil {
+		t.Fatalf("Expected the failed poll to be reported, got %+v", first)
+	}
+	second := <-events
+	if second.Err != nil || second.Kind != diff.Added || second.Key != "Y1" || second.Type != common.SPUSBDataType {
+		t.Fatalf("Expected the YubiKey to be added, got %+v", second)
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	w := New(Options{Interval: time.Second})
+	if got := w.next(time.Second, 10*time.Millisecond, nil); got != time.Second {
+		t.Errorf("Expected the interval after a fast poll, got %v", got)
+	}
+	if got := w.next(time.Second, 800*time.Millisecond, nil); got != 2*time.Second {
+		t.Errorf("Expected a doubled interval after a slow poll, got %v", got)
+	}
+	if got := w.next(8*time.Second, 0, errors.New("failed")); got != 10*time.Second {
+		t.Errorf("Expected the interval to be capped, got %v", got)
+	}
+}